@@ -0,0 +1,126 @@
+// Package epoch 实现基于纪元的内存回收（Epoch-Based Reclamation）
+// 无锁数据结构中被摘下的节点可能仍然被其他 goroutine 持有，不能立即复用，
+// 否则会出现 ABA 问题或者读到被覆盖的数据。
+// 每个操作开始前通过 Pin 进入当前纪元，结束后 Unpin 退出；
+// 被摘下的节点通过 Retire 登记，只有在全局纪元前进两次之后，
+// 也就是所有可能持有该节点的操作都已经结束之后，才会交给 reclaim 回调复用
+package epoch
+
+import (
+	"sync/atomic"
+)
+
+// advanceThreshold 参与者积累的待回收对象达到该数量时，尝试推进全局纪元
+const advanceThreshold = 64
+
+// Domain 是一个回收域，同一个数据结构中的所有操作必须使用同一个 Domain
+type Domain[T any] struct {
+	epoch atomic.Uint64
+	// participants 是所有参与者组成的链表，参与者创建之后不会被删除，只会被复用
+	participants atomic.Pointer[Guard[T]]
+	reclaim      func(t T)
+}
+
+// NewDomain 创建一个回收域，reclaim 会在对象可以被安全复用时调用
+func NewDomain[T any](reclaim func(t T)) *Domain[T] {
+	return &Domain[T]{reclaim: reclaim}
+}
+
+// bag 保存同一个纪元中登记的待回收对象
+type bag[T any] struct {
+	epoch uint64
+	items []T
+}
+
+// Guard 代表一个正在执行的操作，同一时刻只会被一个 goroutine 持有
+type Guard[T any] struct {
+	domain *Domain[T]
+	next   *Guard[T]
+	inUse  atomic.Bool
+	// state 的最低位表示是否处于活跃状态，其余位是进入时的全局纪元
+	state atomic.Uint64
+	// bags 按照纪元对 3 取模存放待回收对象，只会被持有者访问
+	bags    [3]bag[T]
+	retired int
+}
+
+// Pin 进入当前纪元，返回的 Guard 在 Unpin 之前一直保护当前可见的对象不被复用
+func (d *Domain[T]) Pin() *Guard[T] {
+	g := d.acquire()
+	for {
+		e := d.epoch.Load()
+		g.state.Store(e<<1 | 1)
+		// 全局纪元在写入之前没有变化，才能保证之后读到的对象都在保护范围之内
+		if d.epoch.Load() == e {
+			return g
+		}
+	}
+}
+
+// acquire 复用一个空闲的参与者，没有空闲的参与者时创建一个新的
+func (d *Domain[T]) acquire() *Guard[T] {
+	for g := d.participants.Load(); g != nil; g = g.next {
+		if !g.inUse.Load() && g.inUse.CompareAndSwap(false, true) {
+			return g
+		}
+	}
+	g := &Guard[T]{domain: d}
+	g.inUse.Store(true)
+	for {
+		head := d.participants.Load()
+		g.next = head
+		if d.participants.CompareAndSwap(head, g) {
+			return g
+		}
+	}
+}
+
+// Retire 登记一个已经从数据结构中摘下的对象，它会在安全的时候被回收
+func (g *Guard[T]) Retire(t T) {
+	e := g.domain.epoch.Load()
+	b := &g.bags[e%3]
+	if b.epoch != e {
+		// 这个位置上的对象来自至少三个纪元之前，已经可以回收
+		g.collect(b)
+		b.epoch = e
+	}
+	b.items = append(b.items, t)
+	g.retired++
+}
+
+// Unpin 退出纪元，之后不能再访问在 Pin 期间读到的对象
+func (g *Guard[T]) Unpin() {
+	d := g.domain
+	if g.retired >= advanceThreshold {
+		d.tryAdvance()
+		e := d.epoch.Load()
+		for i := range g.bags {
+			if b := &g.bags[i]; b.epoch+2 <= e {
+				g.collect(b)
+			}
+		}
+	}
+	g.state.Store(0)
+	g.inUse.Store(false)
+}
+
+func (g *Guard[T]) collect(b *bag[T]) {
+	for i, t := range b.items {
+		g.domain.reclaim(t)
+		var zero T
+		b.items[i] = zero
+	}
+	g.retired -= len(b.items)
+	b.items = b.items[:0]
+}
+
+// tryAdvance 在所有活跃的参与者都已经进入当前纪元时，推进全局纪元
+func (d *Domain[T]) tryAdvance() {
+	e := d.epoch.Load()
+	for g := d.participants.Load(); g != nil; g = g.next {
+		if s := g.state.Load(); s&1 == 1 && s>>1 != e {
+			return
+		}
+	}
+	d.epoch.CompareAndSwap(e, e+1)
+}
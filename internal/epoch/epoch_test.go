@@ -0,0 +1,85 @@
+package epoch
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomain_Reclaim(t *testing.T) {
+	var reclaimed []int
+	d := NewDomain(func(t int) {
+		reclaimed = append(reclaimed, t)
+	})
+
+	// 其他参与者处于活跃状态时，全局纪元最多前进一次，对象不会被回收
+	reader := d.Pin()
+	for i := 0; i < 4*advanceThreshold; i++ {
+		g := d.Pin()
+		g.Retire(i)
+		g.Unpin()
+	}
+	assert.Empty(t, reclaimed)
+
+	// 所有参与者都退出之后，对象最终会被回收
+	reader.Unpin()
+	for i := 0; i < 4*advanceThreshold; i++ {
+		g := d.Pin()
+		g.Retire(-1)
+		g.Unpin()
+	}
+	assert.Subset(t, reclaimed, []int{0, 1, 4*advanceThreshold - 1})
+}
+
+func TestDomain_ReuseGuard(t *testing.T) {
+	d := NewDomain(func(t int) {})
+	g1 := d.Pin()
+	g2 := d.Pin()
+	assert.NotSame(t, g1, g2)
+	g1.Unpin()
+	// 空闲的参与者会被复用
+	assert.Same(t, g1, d.Pin())
+}
+
+func TestDomain_Concurrent(t *testing.T) {
+	type object struct {
+		reclaimed atomic.Bool
+	}
+	var (
+		shared    atomic.Pointer[object]
+		reclaimed atomic.Int64
+	)
+	shared.Store(&object{})
+	d := NewDomain(func(o *object) {
+		o.reclaimed.Store(true)
+		reclaimed.Add(1)
+	})
+
+	var (
+		wg       sync.WaitGroup
+		violated atomic.Bool
+	)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				guard := d.Pin()
+				o := shared.Load()
+				if shared.CompareAndSwap(o, &object{}) {
+					guard.Retire(o)
+				}
+				// 在 Unpin 之前，读到的对象不能被回收
+				if o.reclaimed.Load() {
+					violated.Store(true)
+				}
+				guard.Unpin()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.False(t, violated.Load())
+	assert.Positive(t, reclaimed.Load())
+}
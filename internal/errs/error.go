@@ -1,7 +1,9 @@
 package errs
 
 import (
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"time"
 )
 
@@ -26,3 +28,21 @@ func NewErrInvalidMaxIntervalValue(maxInterval, initialInterval time.Duration) e
 func NewErrRetryExhausted(lastErr error) error {
 	return fmt.Errorf("mkit: 超过最大重试次数，业务返回的最后一个 error %w", lastErr)
 }
+
+// ErrConcurrentModification 代表视图创建之后，原列表被绕过视图做了结构性修改
+var ErrConcurrentModification = errors.New("mkit: 原列表已被修改，视图失效")
+
+// NewErrInvalidSize 创建一个代表分块、窗口等参数不合法的错误
+func NewErrInvalidSize(name string, size int) error {
+	return fmt.Errorf("mkit: 无效的 %s %d, 预期值应大于 0", name, size)
+}
+
+// NewErrPanic 创建一个代表任务发生 panic 的错误，包含 panic 的值和发生时的调用栈
+func NewErrPanic(r any) error {
+	return fmt.Errorf("mkit: 任务发生 panic: %v\n%s", r, debug.Stack())
+}
+
+// NewErrInvalidArgument 创建一个代表参数不合法的错误
+func NewErrInvalidArgument(name string, value any) error {
+	return fmt.Errorf("mkit: 无效的参数 %s: %v", name, value)
+}
@@ -12,11 +12,7 @@ func Add[T any](src []T, elem T, index int) ([]T, error) {
 	// 先将src扩展一个元素
 	var placeholder T
 	src = append(src, placeholder)
-	for i := len(src) - 1; i > index; i-- {
-		if i-1 >= 0 {
-			src[i] = src[i-1]
-		}
-	}
+	copy(src[index+1:], src[index:])
 
 	src[index] = elem
 	return src, nil
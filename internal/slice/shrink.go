@@ -1,10 +1,10 @@
 package slice
 
-// 缩容标准：
+// CalCapacity 计算切片缩容后的容量，缩容标准：
 //  1. cap小于等于64，不缩容
 //  2. cap小于等于2048且空闲超过3/4，缩容一半
 //  3. cap大于2048且空闲超过一半，缩容到0.625
-func calCapacity(capacity, length int) (int, bool) {
+func CalCapacity(capacity, length int) (int, bool) {
 	if capacity <= 64 {
 		return capacity, false
 	}
@@ -23,13 +23,19 @@ func calCapacity(capacity, length int) (int, bool) {
 
 // 切片缩容
 func Shrink[T any](src []T) []T {
+	return ShrinkFunc(src, CalCapacity)
+}
+
+// ShrinkFunc 使用 calCapacity 计算新容量并对切片缩容
+// calCapacity 返回 false 表示不需要缩容
+func ShrinkFunc[T any](src []T, calCapacity func(capacity, length int) (int, bool)) []T {
 	c, l := cap(src), len(src)
 	if l == 0 {
 		return src
 	}
 
 	n, isShrink := calCapacity(c, l)
-	if isShrink { // 需要缩容
+	if isShrink && n >= l && n < c { // 需要缩容，新容量必须能容纳所有元素
 		s := make([]T, l, n)
 		copy(s, src)
 		return s
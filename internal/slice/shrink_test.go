@@ -108,3 +108,49 @@ func TestShrink(t *testing.T) {
 		})
 	}
 }
+
+// TestShrinkFunc 针对 ShrinkFunc 的单元测试，覆盖自定义缩容标准以及非法的新容量
+func TestShrinkFunc(t *testing.T) {
+	testCases := []struct {
+		name        string
+		slice       []int
+		calCapacity func(capacity, length int) (int, bool)
+		wantCap     int
+	}{
+		{
+			name:        "从不缩容",
+			slice:       make([]int, 1, 4000),
+			calCapacity: func(capacity, length int) (int, bool) { return capacity, false },
+			wantCap:     4000,
+		},
+		{
+			name:        "缩容到长度",
+			slice:       make([]int, 10, 100),
+			calCapacity: func(capacity, length int) (int, bool) { return length, true },
+			wantCap:     10,
+		},
+		{
+			name:        "新容量小于长度，不缩容",
+			slice:       make([]int, 10, 100),
+			calCapacity: func(capacity, length int) (int, bool) { return length - 1, true },
+			wantCap:     100,
+		},
+		{
+			name:        "新容量大于原容量，不扩容",
+			slice:       make([]int, 10, 100),
+			calCapacity: func(capacity, length int) (int, bool) { return capacity * 2, true },
+			wantCap:     100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < len(tc.slice); i++ {
+				tc.slice[i] = i
+			}
+			res := ShrinkFunc(tc.slice, tc.calCapacity)
+			assert.Equal(t, tc.wantCap, cap(res))
+			assert.Equal(t, tc.slice, res)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package list
 
 import (
+	"slices"
+
 	"mkit/internal/errs"
 	"mkit/internal/slice"
 )
@@ -8,15 +10,66 @@ import (
 // ArrayList 是基于切片实现的List
 type ArrayList[T any] struct {
 	elems []T
+	// modCount 记录结构性修改（改变长度）的次数，用于检测 SubList 视图是否失效
+	modCount int
+	// shrinkPolicy 为 nil 时使用 DefaultShrinkPolicy
+	shrinkPolicy ShrinkPolicy
+}
+
+type arrayListOptions struct {
+	capacity     int
+	shrinkPolicy ShrinkPolicy
+}
+
+// ArrayListOption 创建 ArrayList 时的可选配置
+type ArrayListOption func(opts *arrayListOptions)
+
+// WithCapacity 指定 ArrayList 的初始容量
+func WithCapacity(capacity int) ArrayListOption {
+	return func(opts *arrayListOptions) {
+		opts.capacity = capacity
+	}
+}
+
+// WithShrinkPolicy 指定 ArrayList 删除元素之后的缩容策略
+func WithShrinkPolicy(policy ShrinkPolicy) ArrayListOption {
+	return func(opts *arrayListOptions) {
+		opts.shrinkPolicy = policy
+	}
 }
 
 // 这里使用 make([]T, 0) 而不是 []T{}，主要是为了明确地分配一个新的 slice 底层数组，
 // 保证每次创建的 ArrayList 都有独立的底层存储，避免潜在的内存重用问题。
 // 在高性能场景下，make 也可以方便地指定初始容量（如 make([]T, 0, cap)），便于后续扩容优化
-func NewArrayList[T any]() *ArrayList[T] {
+func NewArrayList[T any](opts ...ArrayListOption) *ArrayList[T] {
+	o := newArrayListOptions(opts)
+	return &ArrayList[T]{
+		elems:        make([]T, 0, o.capacity),
+		shrinkPolicy: o.shrinkPolicy,
+	}
+}
+
+// NewArrayListOf 使用 ts 中的元素创建 ArrayList，会复制 ts，之后两者的修改互不影响
+// 容量为 ts 的长度与指定的初始容量中较大的一个
+func NewArrayListOf[T any](ts []T, opts ...ArrayListOption) *ArrayList[T] {
+	o := newArrayListOptions(opts)
+	elems := make([]T, len(ts), max(len(ts), o.capacity))
+	copy(elems, ts)
 	return &ArrayList[T]{
-		elems: make([]T, 0),
+		elems:        elems,
+		shrinkPolicy: o.shrinkPolicy,
+	}
+}
+
+func newArrayListOptions(opts []ArrayListOption) arrayListOptions {
+	var o arrayListOptions
+	for _, opt := range opts {
+		opt(&o)
 	}
+	if o.capacity < 0 {
+		o.capacity = 0
+	}
+	return o
 }
 
 // Get 返回对应下标的元素，在下标超出范围的情况下，返回错误
@@ -30,7 +83,11 @@ func (l *ArrayList[T]) Get(index int) (T, error) {
 
 // Append 在末尾追加元素
 func (l *ArrayList[T]) Append(ts ...T) error {
+	if len(ts) == 0 {
+		return nil
+	}
 	l.elems = append(l.elems, ts...)
+	l.modCount++
 	return nil
 }
 
@@ -44,6 +101,7 @@ func (l *ArrayList[T]) Add(index int, t T) error {
 		return err
 	}
 	l.elems = newElems
+	l.modCount++
 	return nil
 }
 
@@ -58,6 +116,7 @@ func (l *ArrayList[T]) Set(index int, t T) error {
 }
 
 // Delete 删除目标元素的位置，并且返回该位置的值,如有必要会进行缩容
+// 是否缩容由 ShrinkPolicy 决定，默认情况下：
 // - 如果容量 > 2048，并且长度小于容量一半，那么就会缩容为原本的 5/8
 // - 如果容量 (64, 2048]，如果长度是容量的 1/4，那么就会缩容为原本的一半
 // - 如果此时容量 <= 64，那么我们将不会执行缩容。在容量很小的情况下，浪费的内存很少，所以没必要消耗 CPU去执行缩容
@@ -68,12 +127,32 @@ func (l *ArrayList[T]) Remove(index int) (T, error) {
 		return zeroValue, err
 	}
 	l.elems = res
+	l.modCount++
 	l.shrink()
 	return t, nil
 }
 
 func (l *ArrayList[T]) shrink() {
-	l.elems = slice.Shrink(l.elems)
+	if l.shrinkPolicy == nil {
+		l.elems = slice.Shrink(l.elems)
+		return
+	}
+	l.elems = slice.ShrinkFunc(l.elems, l.shrinkPolicy.NewCapacity)
+}
+
+// Grow 保证在不重新分配内存的情况下，至少还能再追加 n 个元素
+// n 小于 0 时不做任何处理
+func (l *ArrayList[T]) Grow(n int) {
+	if n > 0 {
+		l.elems = slices.Grow(l.elems, n)
+	}
+}
+
+// TrimToSize 将容量缩减到与长度相同，释放多余的内存
+func (l *ArrayList[T]) TrimToSize() {
+	if cap(l.elems) > len(l.elems) {
+		l.elems = slices.Clip(slices.Clone(l.elems))
+	}
 }
 
 // Len 返回长度
@@ -109,3 +188,116 @@ func (l *ArrayList[T]) AsSlice() []T {
 	copy(result, l.elems)
 	return result
 }
+
+// Sort 使用 cmp 对 List 原地排序，不保证相等元素的相对顺序
+// cmp(a, b) 在 a < b 时返回负数，a == b 时返回 0，a > b 时返回正数
+func (l *ArrayList[T]) Sort(cmp func(a, b T) int) {
+	slices.SortFunc(l.elems, cmp)
+}
+
+// SortStable 使用 cmp 对 List 原地排序，相等元素保持原有的相对顺序
+func (l *ArrayList[T]) SortStable(cmp func(a, b T) int) {
+	slices.SortStableFunc(l.elems, cmp)
+}
+
+// Reverse 原地反转 List
+func (l *ArrayList[T]) Reverse() {
+	slices.Reverse(l.elems)
+}
+
+// Swap 交换 i 和 j 位置的元素
+// 如果下标超出范围，应该返回错误
+func (l *ArrayList[T]) Swap(i, j int) error {
+	if i < 0 || i >= l.Len() {
+		return errs.NewErrIndexOutOfRange(l.Len(), i)
+	}
+	if j < 0 || j >= l.Len() {
+		return errs.NewErrIndexOutOfRange(l.Len(), j)
+	}
+	l.elems[i], l.elems[j] = l.elems[j], l.elems[i]
+	return nil
+}
+
+// AddAll 在特定下标处依次插入 ts，原有元素整体后移
+// 如果下标不在[0, Len()]范围之内，应该返回错误
+func (l *ArrayList[T]) AddAll(index int, ts ...T) error {
+	if index < 0 || index > l.Len() {
+		return errs.NewErrIndexOutOfRange(l.Len(), index)
+	}
+	if len(ts) == 0 {
+		return nil
+	}
+	l.elems = slices.Insert(l.elems, index, ts...)
+	l.modCount++
+	return nil
+}
+
+// RemoveIf 删除所有满足 pred 的元素，返回被删除的元素个数
+// 只遍历一次，并且至多缩容一次
+func (l *ArrayList[T]) RemoveIf(pred func(t T) bool) int {
+	length := l.Len()
+	l.elems = slices.DeleteFunc(l.elems, pred)
+	if removed := length - l.Len(); removed > 0 {
+		l.modCount++
+		l.shrink()
+		return removed
+	}
+	return 0
+}
+
+// RetainAll 只保留满足 pred 的元素，返回被删除的元素个数
+func (l *ArrayList[T]) RetainAll(pred func(t T) bool) int {
+	return l.RemoveIf(func(t T) bool {
+		return !pred(t)
+	})
+}
+
+// RemoveRange 删除 [from, to) 范围内的元素
+// 如果不满足 0 <= from <= to <= Len()，应该返回错误
+func (l *ArrayList[T]) RemoveRange(from, to int) error {
+	if err := checkRange(l.Len(), from, to); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+	l.elems = slices.Delete(l.elems, from, to)
+	l.modCount++
+	l.shrink()
+	return nil
+}
+
+// Clear 删除所有元素，保留底层数组以便复用
+// 列表已经为空时不做任何操作，也不会使 SubList 视图失效
+func (l *ArrayList[T]) Clear() {
+	if len(l.elems) == 0 {
+		return
+	}
+	clear(l.elems)
+	l.elems = l.elems[:0]
+	l.modCount++
+}
+
+// IndexOf 返回第一个与 t 相等的元素的下标，不存在时返回 -1
+func (l *ArrayList[T]) IndexOf(t T, equal func(src, dst T) bool) int {
+	return slices.IndexFunc(l.elems, func(src T) bool {
+		return equal(src, t)
+	})
+}
+
+// Contains 判断 List 中是否存在与 t 相等的元素
+func (l *ArrayList[T]) Contains(t T, equal func(src, dst T) bool) bool {
+	return l.IndexOf(t, equal) >= 0
+}
+
+// SubList 返回 [from, to) 范围内元素的视图，视图与 ArrayList 共享底层存储
+// 通过视图进行的读写会直接作用在 ArrayList 上；
+// 视图创建之后，如果绕过该视图对 ArrayList 做了结构性修改（改变长度），
+// 那么视图会失效，之后对视图的操作都会返回 ErrConcurrentModification
+// 如果不满足 0 <= from <= to <= Len()，应该返回错误
+func (l *ArrayList[T]) SubList(from, to int) (List[T], error) {
+	if err := checkRange(l.Len(), from, to); err != nil {
+		return nil, err
+	}
+	return &subList[T]{root: l, offset: from, size: to - from, modCount: l.modCount}, nil
+}
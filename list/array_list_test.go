@@ -1,31 +1,13 @@
 package list
 
 import (
-	"errors"
 	"reflect"
 	"strings"
 	"testing"
 )
 
-func TestArrayList_AppendAndGet(t *testing.T) {
-	list := NewArrayList[int]()
-	err := list.Append(1, 2, 3)
-	if err != nil {
-		t.Fatalf("Append 失败: %v", err)
-	}
-	if list.Len() != 3 {
-		t.Fatalf("期望长度3，实际为%d", list.Len())
-	}
-	for i := 0; i < 3; i++ {
-		val, err := list.Get(i)
-		if err != nil {
-			t.Fatalf("Get(%d) 失败: %v", i, err)
-		}
-		if val != i+1 {
-			t.Fatalf("期望值%d，实际为%d", i+1, val)
-		}
-	}
-}
+// List 接口约定的基本行为（Append、Add、Get、Set、Remove、Range、AsSlice 等）
+// 由 conformance_test.go 中的 listtest 覆盖，这里只测试 ArrayList 特有的行为
 
 func TestArrayList_Get_OutOfRange(t *testing.T) {
 	list := NewArrayList[int]()
@@ -41,134 +23,234 @@ func TestArrayList_Get_OutOfRange(t *testing.T) {
 	}
 }
 
-func TestArrayList_Add(t *testing.T) {
-	list := NewArrayList[int]()
-	list.Append(1, 2, 3)
-	err := list.Add(1, 99)
-	if err != nil {
-		t.Fatalf("Add 失败: %v", err)
+func TestArrayList_AddAll(t *testing.T) {
+	testCases := []struct {
+		name    string
+		index   int
+		ts      []int
+		want    []int
+		wantErr bool
+	}{
+		{name: "头部插入", index: 0, ts: []int{7, 8}, want: []int{7, 8, 1, 2, 3}},
+		{name: "中间插入", index: 1, ts: []int{7, 8}, want: []int{1, 7, 8, 2, 3}},
+		{name: "尾部插入", index: 3, ts: []int{7, 8}, want: []int{1, 2, 3, 7, 8}},
+		{name: "插入空元素", index: 1, ts: nil, want: []int{1, 2, 3}},
+		{name: "下标越界", index: 4, ts: []int{7}, want: []int{1, 2, 3}, wantErr: true},
 	}
-	expected := []int{1, 99, 2, 3}
-	actual := list.AsSlice()
-	if !reflect.DeepEqual(actual, expected) {
-		t.Fatalf("Add 后切片不一致，期望%v，实际%v", expected, actual)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.Append(1, 2, 3)
+			err := list.AddAll(tc.index, tc.ts...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("AddAll 错误不符合预期: %v", err)
+			}
+			if actual := list.AsSlice(); !reflect.DeepEqual(actual, tc.want) {
+				t.Fatalf("AddAll 后切片不一致，期望%v，实际%v", tc.want, actual)
+			}
+		})
 	}
 }
 
-func TestArrayList_Add_OutOfRange(t *testing.T) {
+func TestArrayList_RemoveIf(t *testing.T) {
 	list := NewArrayList[int]()
-	err := list.Add(2, 10)
-	if err == nil {
-		t.Fatal("期望越界错误，但未发生")
+	list.Append(1, 2, 3, 4, 5, 6)
+	removed := list.RemoveIf(func(t int) bool { return t%2 == 0 })
+	if removed != 3 {
+		t.Fatalf("RemoveIf 删除个数错误，期望3，实际%d", removed)
+	}
+	if actual := list.AsSlice(); !reflect.DeepEqual(actual, []int{1, 3, 5}) {
+		t.Fatalf("RemoveIf 后切片不一致，实际%v", actual)
+	}
+
+	removed = list.RetainAll(func(t int) bool { return t > 1 })
+	if removed != 1 {
+		t.Fatalf("RetainAll 删除个数错误，期望1，实际%d", removed)
+	}
+	if actual := list.AsSlice(); !reflect.DeepEqual(actual, []int{3, 5}) {
+		t.Fatalf("RetainAll 后切片不一致，实际%v", actual)
 	}
 }
 
-func TestArrayList_Set(t *testing.T) {
+func TestArrayList_RemoveIf_ShrinkOnce(t *testing.T) {
 	list := NewArrayList[int]()
-	list.Append(1, 2, 3)
-	err := list.Set(1, 100)
-	if err != nil {
-		t.Fatalf("Set 失败: %v", err)
+	for i := 0; i < 1000; i++ {
+		list.Append(i)
+	}
+	before := list.Cap()
+	list.RemoveIf(func(t int) bool { return t >= 10 })
+	// 逐个删除会缩容多次，批量删除只会按最终长度缩容一次
+	if list.Cap() != before/2 {
+		t.Fatalf("RemoveIf 应只缩容一次，期望容量%d，实际%d", before/2, list.Cap())
 	}
-	val, _ := list.Get(1)
-	if val != 100 {
-		t.Fatalf("Set 后值不正确，期望100，实际%d", val)
+	if list.Len() != 10 {
+		t.Fatalf("RemoveIf 后长度应为10，实际为%d", list.Len())
 	}
 }
 
-func TestArrayList_Set_OutOfRange(t *testing.T) {
-	list := NewArrayList[int]()
-	err := list.Set(0, 10)
-	if err == nil {
-		t.Fatal("期望越界错误，但未发生")
+func TestArrayList_RemoveRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int
+		want     []int
+		wantErr  bool
+	}{
+		{name: "删除头部", from: 0, to: 2, want: []int{3, 4, 5}},
+		{name: "删除中间", from: 1, to: 4, want: []int{1, 5}},
+		{name: "删除尾部", from: 3, to: 5, want: []int{1, 2, 3}},
+		{name: "删除全部", from: 0, to: 5, want: []int{}},
+		{name: "空区间", from: 2, to: 2, want: []int{1, 2, 3, 4, 5}},
+		{name: "from越界", from: -1, to: 2, want: []int{1, 2, 3, 4, 5}, wantErr: true},
+		{name: "to越界", from: 0, to: 6, want: []int{1, 2, 3, 4, 5}, wantErr: true},
+		{name: "from大于to", from: 3, to: 2, want: []int{1, 2, 3, 4, 5}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list := NewArrayList[int]()
+			list.Append(1, 2, 3, 4, 5)
+			err := list.RemoveRange(tc.from, tc.to)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RemoveRange 错误不符合预期: %v", err)
+			}
+			if actual := list.AsSlice(); !reflect.DeepEqual(actual, tc.want) {
+				t.Fatalf("RemoveRange 后切片不一致，期望%v，实际%v", tc.want, actual)
+			}
+		})
 	}
 }
 
-func TestArrayList_Delete(t *testing.T) {
+func TestArrayList_Clear(t *testing.T) {
 	list := NewArrayList[int]()
 	list.Append(1, 2, 3)
-	val, err := list.Remove(1)
-	if err != nil {
-		t.Fatalf("Delete 失败: %v", err)
-	}
-	if val != 2 {
-		t.Fatalf("Delete 返回值错误，期望2，实际%d", val)
+	list.Clear()
+	if list.Len() != 0 {
+		t.Fatalf("Clear 后长度应为0，实际为%d", list.Len())
 	}
-	expected := []int{1, 3}
-	actual := list.AsSlice()
-	if !reflect.DeepEqual(actual, expected) {
-		t.Fatalf("Delete 后切片不一致，期望%v，实际%v", expected, actual)
+	list.Append(4)
+	if actual := list.AsSlice(); !reflect.DeepEqual(actual, []int{4}) {
+		t.Fatalf("Clear 后追加结果错误，实际%v", actual)
 	}
 }
 
-func TestArrayList_Delete_OutOfRange(t *testing.T) {
-	list := NewArrayList[int]()
-	_, err := list.Remove(0)
-	if err == nil {
-		t.Fatal("期望越界错误，但未发生")
+func TestArrayList_IndexOf(t *testing.T) {
+	list := NewArrayList[string]()
+	list.Append("Go", "Java", "go")
+	equalFold := func(src, dst string) bool { return strings.EqualFold(src, dst) }
+	if index := list.IndexOf("GO", equalFold); index != 0 {
+		t.Fatalf("IndexOf 期望0，实际%d", index)
+	}
+	if index := list.IndexOf("rust", equalFold); index != -1 {
+		t.Fatalf("IndexOf 期望-1，实际%d", index)
+	}
+	if !list.Contains("java", equalFold) {
+		t.Fatal("Contains 期望为 true")
+	}
+	if list.Contains("c", equalFold) {
+		t.Fatal("Contains 期望为 false")
 	}
 }
 
-func TestArrayList_Range(t *testing.T) {
-	list := NewArrayList[int]()
-	list.Append(1, 2, 3)
-	sum := 0
-	err := list.Range(func(index int, t int) error {
-		sum += t
-		return nil
-	})
-	if err != nil {
-		t.Fatalf("Range 失败: %v", err)
+func TestNewArrayList_WithCapacity(t *testing.T) {
+	list := NewArrayList[int](WithCapacity(16))
+	if list.Len() != 0 || list.Cap() != 16 {
+		t.Fatalf("期望长度0容量16，实际长度%d容量%d", list.Len(), list.Cap())
 	}
-	if sum != 6 {
-		t.Fatalf("Range 累加错误，期望6，实际%d", sum)
+
+	list = NewArrayList[int](WithCapacity(-1))
+	if list.Cap() != 0 {
+		t.Fatalf("负数容量应按0处理，实际为%d", list.Cap())
 	}
 }
 
-func TestArrayList_Range_EarlyExit(t *testing.T) {
-	list := NewArrayList[int]()
-	list.Append(1, 2, 3)
-	errTest := errors.New("test")
-	err := list.Range(func(index int, t int) error {
-		if index == 1 {
-			return errTest
-		}
-		return nil
-	})
-	if !errors.Is(err, errTest) {
-		t.Fatalf("Range 未能提前退出，期望%v，实际%v", errTest, err)
+func TestNewArrayListOf(t *testing.T) {
+	src := []int{1, 2, 3}
+	list := NewArrayListOf(src)
+	if actual := list.AsSlice(); !reflect.DeepEqual(actual, src) {
+		t.Fatalf("NewArrayListOf 结果错误，实际%v", actual)
+	}
+	// NewArrayListOf 会复制传入的切片，两者的修改互不影响
+	src[0] = 100
+	if val, _ := list.Get(0); val != 1 {
+		t.Fatalf("修改传入的切片不应影响列表，实际%d", val)
+	}
+	_ = list.Set(1, 200)
+	_ = list.Append(4)
+	if !reflect.DeepEqual(src, []int{100, 2, 3}) || !reflect.DeepEqual(src[:cap(src)], []int{100, 2, 3}) {
+		t.Fatalf("修改列表不应影响传入的切片，实际%v", src)
+	}
+	// 容量足够时追加也不能写入传入切片的底层数组
+	spare := make([]int, 2, 10)
+	list = NewArrayListOf(spare)
+	_ = list.Append(7)
+	if spare[:3][2] != 0 {
+		t.Fatalf("追加不应写入传入切片的底层数组，实际%v", spare[:3])
+	}
+
+	list = NewArrayListOf(src, WithCapacity(10))
+	if list.Len() != 3 || list.Cap() < 10 {
+		t.Fatalf("期望长度3容量至少10，实际长度%d容量%d", list.Len(), list.Cap())
+	}
+
+	list = NewArrayListOf[int](nil)
+	if slice := list.AsSlice(); slice == nil || len(slice) != 0 {
+		t.Fatalf("nil 切片应创建空列表，实际%v", slice)
 	}
 }
 
-func TestArrayList_AsSlice(t *testing.T) {
+func TestArrayList_GrowAndTrimToSize(t *testing.T) {
 	list := NewArrayList[int]()
-	slice1 := list.AsSlice()
-	if slice1 == nil || len(slice1) != 0 {
-		t.Fatalf("空列表AsSlice应返回空切片，实际为%v", slice1)
+	list.Append(1, 2, 3)
+	list.Grow(100)
+	if list.Cap() < 103 {
+		t.Fatalf("Grow 后容量至少为103，实际为%d", list.Cap())
+	}
+	capacity := list.Cap()
+	list.Grow(-1)
+	list.Grow(10)
+	if list.Cap() != capacity {
+		t.Fatalf("容量足够时 Grow 不应重新分配，期望%d，实际%d", capacity, list.Cap())
 	}
-	list.Append(1, 2)
-	slice2 := list.AsSlice()
-	if !reflect.DeepEqual(slice2, []int{1, 2}) {
-		t.Fatalf("AsSlice 返回值错误，期望[1 2]，实际%v", slice2)
+
+	list.TrimToSize()
+	if list.Cap() != 3 {
+		t.Fatalf("TrimToSize 后容量应为3，实际为%d", list.Cap())
 	}
-	// 检查返回的是新切片
-	slice2[0] = 100
-	val, _ := list.Get(0)
-	if val == 100 {
-		t.Fatal("AsSlice 返回的切片应为新切片，修改不应影响原数据")
+	if actual := list.AsSlice(); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Fatalf("TrimToSize 不应改变元素，实际%v", actual)
 	}
 }
 
-func TestArrayList_LenAndCap(t *testing.T) {
-	list := NewArrayList[int]()
-	if list.Len() != 0 {
-		t.Fatalf("新建列表长度应为0，实际为%d", list.Len())
+func TestArrayList_ShrinkPolicy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  ShrinkPolicy
+		wantCap int
+	}{
+		{name: "默认策略", policy: DefaultShrinkPolicy(), wantCap: 512},
+		{name: "从不缩容", policy: NeverShrinkPolicy(), wantCap: 1024},
+		{
+			name: "自定义策略",
+			policy: ShrinkPolicyFunc(func(capacity, length int) (int, bool) {
+				return length, true
+			}),
+			wantCap: 99,
+		},
 	}
-	list.Append(1, 2, 3)
-	if list.Len() != 3 {
-		t.Fatalf("追加后长度应为3，实际为%d", list.Len())
-	}
-	if list.Cap() < 3 {
-		t.Fatalf("容量应大于等于3，实际为%d", list.Cap())
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list := NewArrayList[int](WithCapacity(1024), WithShrinkPolicy(tc.policy))
+			for i := 0; i < 100; i++ {
+				list.Append(i)
+			}
+			if _, err := list.Remove(0); err != nil {
+				t.Fatalf("Remove 失败: %v", err)
+			}
+			if list.Cap() != tc.wantCap {
+				t.Fatalf("期望容量%d，实际%d", tc.wantCap, list.Cap())
+			}
+		})
 	}
 }
@@ -0,0 +1,53 @@
+package list
+
+import (
+	"slices"
+
+	"mkit/slice"
+)
+
+// Equal 判断两个 List 的长度相同，并且对应下标的元素都满足 eq
+func Equal[T any](a, b ReadOnlyList[T], eq func(x, y T) bool) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	// 对 LinkedList 等实现逐个 Get 的代价是 O(n)，因此先把其中一个转为切片
+	bs := b.AsSlice()
+	equal := true
+	_ = a.Range(func(index int, t T) error {
+		if !eq(t, bs[index]) {
+			equal = false
+			return errStopRange
+		}
+		return nil
+	})
+	return equal
+}
+
+// Clone 返回 l 的一个副本，修改副本不会影响 l
+// 对于本包中的实现，副本与 l 的类型以及创建时的配置相同；
+// 其他实现（包括 SubList 视图与 SortedList）会复制为 ArrayList
+func Clone[T any](l ReadOnlyList[T]) List[T] {
+	switch src := l.(type) {
+	case *ArrayList[T]:
+		return &ArrayList[T]{elems: slices.Clone(src.elems), shrinkPolicy: src.shrinkPolicy}
+	case *LinkedList[T]:
+		res := NewLinkedList[T](WithNodePool(src.maxFree), WithNodeArena(src.arenaLen))
+		_ = res.Append(src.values()...)
+		return res
+	case *UnrolledLinkedList[T]:
+		res := newUnrolledLinkedList[T](src.nodeSize)
+		_ = res.Append(src.AsSlice()...)
+		return res
+	case *GapBuffer[T]:
+		return &GapBuffer[T]{buf: slices.Clone(src.buf), gapStart: src.gapStart, gapEnd: src.gapEnd}
+	default:
+		return &ArrayList[T]{elems: l.AsSlice()}
+	}
+}
+
+// Diff 计算从 old 变为 cur 所需要的插入、删除和移动，参考 slice.Diff
+// 会先通过 AsSlice 复制两个 List，额外占用 O(n+m) 的空间，时间复杂度与 slice.Diff 相同
+func Diff[T any](old, cur ReadOnlyList[T], eq func(a, b T) bool) []slice.Edit[T] {
+	return slice.Diff(old.AsSlice(), cur.AsSlice(), eq)
+}
@@ -0,0 +1,75 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"mkit/slice"
+)
+
+func TestEqual(t *testing.T) {
+	eq := func(x, y int) bool { return x == y }
+	testCases := []struct {
+		name string
+		a, b List[int]
+		want bool
+	}{
+		{name: "都为空", a: NewArrayList[int](), b: NewLinkedList[int](), want: true},
+		{name: "不同实现相同元素", a: NewArrayListOf([]int{1, 2, 3}), b: NewLinkedListOf([]int{1, 2, 3}), want: true},
+		{name: "长度不同", a: NewArrayListOf([]int{1, 2}), b: NewArrayListOf([]int{1, 2, 3})},
+		{name: "元素不同", a: NewUnrolledLinkedListOf([]int{1, 2, 3}), b: NewGapBufferOf([]int{1, 5, 3})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Equal(tc.a, tc.b, eq))
+			assert.Equal(t, tc.want, Equal(tc.b, tc.a, eq))
+		})
+	}
+}
+
+func TestClone(t *testing.T) {
+	parent := NewArrayListOf([]int{0, 1, 2, 3})
+	sub, err := parent.SubList(1, 3)
+	require.NoError(t, err)
+	gap := NewGapBufferOf([]int{1, 2, 3})
+	require.NoError(t, gap.MoveCursor(1))
+
+	testCases := []struct {
+		name string
+		src  List[int]
+	}{
+		{name: "ArrayList", src: NewArrayListOf([]int{1, 2, 3}, WithShrinkPolicy(NeverShrinkPolicy()))},
+		{name: "LinkedList", src: NewLinkedListOf([]int{1, 2, 3}, WithNodePool(4))},
+		{name: "UnrolledLinkedList", src: NewUnrolledLinkedListOf([]int{1, 2, 3})},
+		{name: "GapBuffer", src: gap},
+		{name: "SubList", src: sub},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := tc.src.AsSlice()
+			res := Clone(tc.src)
+			assert.Equal(t, want, res.AsSlice())
+			if _, ok := tc.src.(*subList[int]); ok {
+				assert.IsType(t, &ArrayList[int]{}, res)
+			} else {
+				assert.IsType(t, tc.src, res)
+			}
+
+			// 副本与原 List 相互独立
+			require.NoError(t, res.Set(0, 100))
+			require.NoError(t, res.Append(200))
+			assert.Equal(t, want, tc.src.AsSlice())
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := NewLinkedListOf([]int{1, 2, 3, 4})
+	cur := NewArrayListOf([]int{2, 3, 4, 1, 5})
+	assert.Equal(t, []slice.Edit[int]{
+		{Kind: slice.EditMove, OldIndex: 0, NewIndex: 3, Value: 1},
+		{Kind: slice.EditInsert, OldIndex: -1, NewIndex: 4, Value: 5},
+	}, Diff[int](old, cur, func(a, b int) bool { return a == b }))
+}
@@ -0,0 +1,80 @@
+package list_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"mkit/list"
+	"mkit/list/listtest"
+)
+
+func TestConformance(t *testing.T) {
+	genInt := func(r *rand.Rand) int { return r.Intn(1000) }
+
+	testCases := []struct {
+		name string
+		new  func() list.List[int]
+	}{
+		{
+			name: "ArrayList",
+			new:  func() list.List[int] { return list.NewArrayList[int]() },
+		},
+		{
+			name: "ArrayList-NeverShrink",
+			new: func() list.List[int] {
+				return list.NewArrayList[int](list.WithShrinkPolicy(list.NeverShrinkPolicy()))
+			},
+		},
+		{
+			name: "LinkedList",
+			new:  func() list.List[int] { return list.NewLinkedList[int]() },
+		},
+		{
+			name: "LinkedList-NodePool",
+			new:  func() list.List[int] { return list.NewLinkedList[int](list.WithNodePool(8)) },
+		},
+		{
+			name: "LinkedList-NodeArena",
+			new:  func() list.List[int] { return list.NewLinkedList[int](list.WithNodeArena(8)) },
+		},
+		{
+			name: "UnrolledLinkedList",
+			new:  func() list.List[int] { return list.NewUnrolledLinkedList[int]() },
+		},
+		{
+			// 使用较小的节点，频繁触发节点的拆分、合并与借用
+			name: "UnrolledLinkedList-SmallNode",
+			new:  func() list.List[int] { return list.NewUnrolledLinkedListWithNodeSize[int](4) },
+		},
+		{
+			name: "GapBuffer",
+			new:  func() list.List[int] { return list.NewGapBuffer[int](0) },
+		},
+		{
+			// 视图前后都有元素，验证视图的读写不会越过自己的范围
+			name: "SubList",
+			new: func() list.List[int] {
+				parent := list.NewArrayListOf([]int{-1, -2, -3, -4})
+				sub, err := parent.SubList(2, 2)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return sub
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listtest.Run(t, listtest.Config[int]{New: tc.new, Gen: genInt})
+		})
+	}
+}
+
+func TestConformance_String(t *testing.T) {
+	listtest.Run(t, listtest.Config[string]{
+		New: func() list.List[string] { return list.NewLinkedList[string]() },
+		Gen: func(r *rand.Rand) string { return strconv.Itoa(r.Int()) },
+	})
+}
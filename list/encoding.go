@@ -0,0 +1,149 @@
+package list
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+)
+
+var (
+	_ json.Marshaler             = ArrayList[any]{}
+	_ json.Unmarshaler           = &ArrayList[any]{}
+	_ encoding.BinaryMarshaler   = ArrayList[any]{}
+	_ encoding.BinaryUnmarshaler = &ArrayList[any]{}
+	_ gob.GobEncoder             = ArrayList[any]{}
+	_ gob.GobDecoder             = &ArrayList[any]{}
+
+	_ json.Marshaler             = LinkedList[any]{}
+	_ json.Unmarshaler           = &LinkedList[any]{}
+	_ encoding.BinaryMarshaler   = LinkedList[any]{}
+	_ encoding.BinaryUnmarshaler = &LinkedList[any]{}
+	_ gob.GobEncoder             = LinkedList[any]{}
+	_ gob.GobDecoder             = &LinkedList[any]{}
+)
+
+// 列表统一序列化为元素组成的数组：
+// JSON 格式为 JSON 数组，二进制格式为 gob 编码的切片。
+// 反序列化会替换列表中原有的全部元素，JSON 中的 null 会被当作空列表。
+// 序列化的方法只读取元素，因此定义在值接收者上：以值的形式保存在结构体字段中、
+// 或者不可寻址的列表同样可以正确地序列化，而不会被当作普通结构体编码为 {}。
+
+// MarshalJSON 将 ArrayList 序列化为 JSON 数组
+func (l ArrayList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.AsSlice())
+}
+
+// UnmarshalJSON 从 JSON 数组中恢复 ArrayList
+func (l *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	var ts []T
+	if err := json.Unmarshal(data, &ts); err != nil {
+		return err
+	}
+	l.reset(ts)
+	return nil
+}
+
+// MarshalBinary 使用 gob 将 ArrayList 编码为二进制
+func (l ArrayList[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(l.AsSlice())
+}
+
+// UnmarshalBinary 从 MarshalBinary 的结果中恢复 ArrayList
+func (l *ArrayList[T]) UnmarshalBinary(data []byte) error {
+	var ts []T
+	if err := decodeGob(data, &ts); err != nil {
+		return err
+	}
+	l.reset(ts)
+	return nil
+}
+
+// GobEncode 实现 gob.GobEncoder，与 MarshalBinary 相同
+func (l ArrayList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode 实现 gob.GobDecoder，与 UnmarshalBinary 相同
+func (l *ArrayList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+func (l *ArrayList[T]) reset(ts []T) {
+	if ts == nil {
+		ts = make([]T, 0)
+	}
+	l.elems = ts
+	l.modCount++
+}
+
+// MarshalJSON 将 LinkedList 序列化为 JSON 数组
+func (l LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.values())
+}
+
+// UnmarshalJSON 从 JSON 数组中恢复 LinkedList
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	var ts []T
+	if err := json.Unmarshal(data, &ts); err != nil {
+		return err
+	}
+	l.reset(ts)
+	return nil
+}
+
+// MarshalBinary 使用 gob 将 LinkedList 编码为二进制
+func (l LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(l.values())
+}
+
+// UnmarshalBinary 从 MarshalBinary 的结果中恢复 LinkedList
+func (l *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	var ts []T
+	if err := decodeGob(data, &ts); err != nil {
+		return err
+	}
+	l.reset(ts)
+	return nil
+}
+
+// GobEncode 实现 gob.GobEncoder，与 MarshalBinary 相同
+func (l LinkedList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// GobDecode 实现 gob.GobDecoder，与 UnmarshalBinary 相同
+func (l *LinkedList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// values 与 AsSlice 相同，但是兼容零值 LinkedList
+// 反序列化嵌套的元素时，encoding/json 和 encoding/gob 都会直接创建零值
+func (l *LinkedList[T]) values() []T {
+	if l.head == nil {
+		return make([]T, 0)
+	}
+	return l.AsSlice()
+}
+
+func (l *LinkedList[T]) reset(ts []T) {
+	if l.head == nil {
+		*l = *NewLinkedList[T]()
+	} else {
+		// 保留创建时的配置
+		l.Clear()
+	}
+	_ = l.Append(ts...)
+}
+
+func encodeGob(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeGob(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
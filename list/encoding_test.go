@@ -0,0 +1,195 @@
+package list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pair[K comparable, V any] struct {
+	Key    K
+	Values *LinkedList[V]
+}
+
+func TestArrayList_JSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      []int
+		wantJSON string
+	}{
+		{name: "空列表", src: []int{}, wantJSON: `[]`},
+		{name: "多个元素", src: []int{1, 2, 3}, wantJSON: `[1,2,3]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(NewArrayListOf(tc.src))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.wantJSON, string(data))
+
+			l := NewArrayList[int]()
+			_ = l.Append(9, 9)
+			require.NoError(t, json.Unmarshal(data, l))
+			assert.Equal(t, tc.src, l.AsSlice())
+		})
+	}
+
+	l := NewArrayList[int]()
+	require.NoError(t, json.Unmarshal([]byte(`null`), l))
+	assert.Equal(t, []int{}, l.AsSlice())
+	assert.Error(t, json.Unmarshal([]byte(`{"a":1}`), l))
+}
+
+func TestLinkedList_JSON(t *testing.T) {
+	data, err := json.Marshal(NewLinkedListOf([]string{"a", "b"}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `["a","b"]`, string(data))
+
+	// 零值 LinkedList 同样可以序列化和反序列化
+	var l LinkedList[string]
+	data, err = json.Marshal(&l)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(data))
+	require.NoError(t, json.Unmarshal([]byte(`["x","y","z"]`), &l))
+	assert.Equal(t, []string{"x", "y", "z"}, l.AsSlice())
+	assert.Equal(t, 3, l.Len())
+	assert.Error(t, json.Unmarshal([]byte(`[1]`), &l))
+}
+
+func TestList_JSON_Nested(t *testing.T) {
+	type payload struct {
+		Name  string                              `json:"name"`
+		Lists *ArrayList[*LinkedList[int]]        `json:"lists"`
+		Pairs *LinkedList[pair[string, []string]] `json:"pairs"`
+	}
+
+	src := payload{
+		Name:  "nested",
+		Lists: NewArrayListOf([]*LinkedList[int]{NewLinkedListOf([]int{1, 2}), NewLinkedList[int]()}),
+		Pairs: NewLinkedListOf([]pair[string, []string]{
+			{Key: "k", Values: NewLinkedListOf([][]string{{"a"}, {}})},
+		}),
+	}
+	data, err := json.Marshal(src)
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"name":"nested","lists":[[1,2],[]],"pairs":[{"Key":"k","Values":[["a"],[]]}]}`,
+		string(data))
+
+	var dst payload
+	require.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, "nested", dst.Name)
+	require.Equal(t, 2, dst.Lists.Len())
+	first, _ := dst.Lists.Get(0)
+	second, _ := dst.Lists.Get(1)
+	assert.Equal(t, []int{1, 2}, first.AsSlice())
+	assert.Equal(t, []int{}, second.AsSlice())
+	p, err := dst.Pairs.Get(0)
+	require.NoError(t, err)
+	assert.Equal(t, "k", p.Key)
+	assert.Equal(t, [][]string{{"a"}, {}}, p.Values.AsSlice())
+}
+
+func TestList_Binary(t *testing.T) {
+	al := NewArrayListOf([]string{"a", "b", "c"})
+	data, err := al.MarshalBinary()
+	require.NoError(t, err)
+	decodedArray := NewArrayList[string]()
+	require.NoError(t, decodedArray.UnmarshalBinary(data))
+	assert.Equal(t, al.AsSlice(), decodedArray.AsSlice())
+
+	ll := NewLinkedListOf([]float64{1.5, 2.5})
+	data, err = ll.MarshalBinary()
+	require.NoError(t, err)
+	var decodedLinked LinkedList[float64]
+	require.NoError(t, decodedLinked.UnmarshalBinary(data))
+	assert.Equal(t, ll.AsSlice(), decodedLinked.AsSlice())
+
+	empty, err := NewArrayList[int]().MarshalBinary()
+	require.NoError(t, err)
+	decodedEmpty := NewArrayListOf([]int{1})
+	require.NoError(t, decodedEmpty.UnmarshalBinary(empty))
+	assert.Equal(t, []int{}, decodedEmpty.AsSlice())
+
+	assert.Error(t, decodedArray.UnmarshalBinary([]byte("invalid")))
+}
+
+func TestList_Gob_Nested(t *testing.T) {
+	type payload struct {
+		Matrix *ArrayList[*LinkedList[int]]
+		Groups *LinkedList[pair[int, string]]
+	}
+
+	src := payload{
+		Matrix: NewArrayListOf([]*LinkedList[int]{
+			NewLinkedListOf([]int{1, 2, 3}),
+			NewLinkedListOf([]int{4}),
+		}),
+		Groups: NewLinkedListOf([]pair[int, string]{
+			{Key: 1, Values: NewLinkedListOf([]string{"a", "b"})},
+		}),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(src))
+	var dst payload
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&dst))
+
+	require.Equal(t, 2, dst.Matrix.Len())
+	row, _ := dst.Matrix.Get(0)
+	assert.Equal(t, []int{1, 2, 3}, row.AsSlice())
+	row, _ = dst.Matrix.Get(1)
+	assert.Equal(t, []int{4}, row.AsSlice())
+	group, err := dst.Groups.Get(0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, group.Key)
+	assert.Equal(t, []string{"a", "b"}, group.Values.AsSlice())
+}
+
+func TestArrayList_Unmarshal_InvalidatesSubList(t *testing.T) {
+	l := NewArrayListOf([]int{1, 2, 3})
+	sub, err := l.SubList(0, 2)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(`[4,5,6]`), l))
+	_, err = sub.Get(0)
+	assert.ErrorIs(t, err, ErrConcurrentModification)
+}
+
+// 以值的形式保存在结构体字段中的列表，即使外层结构体不可寻址，也能按照数组序列化
+func TestList_ByValue(t *testing.T) {
+	type payload struct {
+		Array  ArrayList[int]
+		Linked LinkedList[string]
+		Empty  ArrayList[int]
+	}
+	src := payload{
+		Array:  *NewArrayListOf([]int{1, 2}),
+		Linked: *NewLinkedListOf([]string{"a", "b"}),
+	}
+
+	// 直接传入值，字段不可寻址
+	data, err := json.Marshal(src)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Array":[1,2],"Linked":["a","b"],"Empty":[]}`, string(data))
+	var dst payload
+	require.NoError(t, json.Unmarshal(data, &dst))
+	assert.Equal(t, []int{1, 2}, dst.Array.AsSlice())
+	assert.Equal(t, []string{"a", "b"}, dst.Linked.AsSlice())
+	assert.Equal(t, []int{}, dst.Empty.AsSlice())
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(src))
+	dst = payload{}
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&dst))
+	assert.Equal(t, []int{1, 2}, dst.Array.AsSlice())
+	assert.Equal(t, []string{"a", "b"}, dst.Linked.AsSlice())
+	assert.Equal(t, []int{}, dst.Empty.AsSlice())
+
+	data, err = json.Marshal([]ArrayList[int]{*NewArrayListOf([]int{3})})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[[3]]`, string(data))
+}
@@ -0,0 +1,6 @@
+package list
+
+import "mkit/internal/errs"
+
+// ErrConcurrentModification 视图创建之后原列表被结构性修改
+var ErrConcurrentModification = errs.ErrConcurrentModification
@@ -0,0 +1,6 @@
+package list
+
+// NewUnrolledLinkedListWithNodeSize 仅用于测试，使用较小的节点触发节点的拆分与合并
+func NewUnrolledLinkedListWithNodeSize[T any](nodeSize int) *UnrolledLinkedList[T] {
+	return newUnrolledLinkedList[T](nodeSize)
+}
@@ -0,0 +1,211 @@
+package list
+
+import "mkit/internal/errs"
+
+var (
+	_ List[any] = &GapBuffer[any]{}
+)
+
+// defaultGapBufferCapacity 第一次插入时分配的最小容量
+const defaultGapBufferCapacity = 16
+
+// GapBuffer 间隙缓冲区（Gap Buffer）
+// 元素存储在一个数组中，数组中间留有一段空闲的间隙，间隙所在的位置相当于编辑器中的光标。
+// 在光标处插入、删除只需要改变间隙的边界，均摊 O(1)；
+// 在其他位置操作时，先把间隙移动过去，代价与移动的距离成正比。
+// 因此适合插入、删除集中在一个缓慢移动的位置附近的场景，例如文本编辑
+type GapBuffer[T any] struct {
+	buf []T
+	// [gapStart, gapEnd) 是间隙，其中的元素都是零值
+	gapStart int
+	gapEnd   int
+}
+
+// NewGapBuffer 创建一个容量为 capacity 的间隙缓冲区，负数容量按 0 处理
+func NewGapBuffer[T any](capacity int) *GapBuffer[T] {
+	capacity = max(capacity, 0)
+	return &GapBuffer[T]{buf: make([]T, capacity), gapEnd: capacity}
+}
+
+// NewGapBufferOf 使用 ts 中的元素创建间隙缓冲区，会复制 ts，光标位于末尾
+func NewGapBufferOf[T any](ts []T) *GapBuffer[T] {
+	g := NewGapBuffer[T](len(ts))
+	_ = g.Append(ts...)
+	return g
+}
+
+func (g *GapBuffer[T]) gapLen() int {
+	return g.gapEnd - g.gapStart
+}
+
+// physical 将逻辑下标转化为 buf 中的下标
+func (g *GapBuffer[T]) physical(index int) int {
+	if index < g.gapStart {
+		return index
+	}
+	return index + g.gapLen()
+}
+
+func (g *GapBuffer[T]) checkIndex(index int) bool {
+	return 0 <= index && index < g.Len()
+}
+
+// Cursor 返回光标（间隙）所在的下标
+func (g *GapBuffer[T]) Cursor() int {
+	return g.gapStart
+}
+
+// MoveCursor 将光标移动到 index 处，之后在 index 附近的插入和删除都是均摊 O(1) 的
+// 如果下标不在[0, Len()]范围之内，应该返回错误
+func (g *GapBuffer[T]) MoveCursor(index int) error {
+	if index < 0 || index > g.Len() {
+		return errs.NewErrIndexOutOfRange(g.Len(), index)
+	}
+	g.moveGap(index)
+	return nil
+}
+
+// moveGap 将间隙移动到 index 处，调用者需要保证 index 合法
+func (g *GapBuffer[T]) moveGap(index int) {
+	switch {
+	case index < g.gapStart:
+		// 间隙左移，[index, gapStart) 搬到间隙的右侧
+		n := g.gapStart - index
+		copy(g.buf[g.gapEnd-n:g.gapEnd], g.buf[index:g.gapStart])
+		clear(g.buf[index:min(g.gapStart, g.gapEnd-n)])
+		g.gapStart, g.gapEnd = index, g.gapEnd-n
+	case index > g.gapStart:
+		// 间隙右移，间隙右侧的 n 个元素搬到间隙的左侧
+		n := index - g.gapStart
+		copy(g.buf[g.gapStart:index], g.buf[g.gapEnd:g.gapEnd+n])
+		clear(g.buf[max(index, g.gapEnd) : g.gapEnd+n])
+		g.gapStart, g.gapEnd = index, g.gapEnd+n
+	}
+}
+
+// grow 保证间隙中至少有 n 个空位，扩容时容量至少翻倍
+func (g *GapBuffer[T]) grow(n int) {
+	if g.gapLen() >= n {
+		return
+	}
+	length := g.Len()
+	capacity := max(2*len(g.buf), length+n, defaultGapBufferCapacity)
+	buf := make([]T, capacity)
+	copy(buf, g.buf[:g.gapStart])
+	tail := len(g.buf) - g.gapEnd
+	copy(buf[capacity-tail:], g.buf[g.gapEnd:])
+	g.buf, g.gapEnd = buf, capacity-tail
+}
+
+// Get 返回对应下标的元素，在下标超出范围的情况下，返回错误
+func (g *GapBuffer[T]) Get(index int) (T, error) {
+	if !g.checkIndex(index) {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(g.Len(), index)
+	}
+	return g.buf[g.physical(index)], nil
+}
+
+// Append 在末尾追加元素，会将光标移动到末尾
+func (g *GapBuffer[T]) Append(ts ...T) error {
+	return g.AddAll(g.Len(), ts...)
+}
+
+// Add 在特定下标处增加一个新元素，之后光标位于新元素之后
+// 如果下标不在[0, Len()]范围之内
+// 应该返回错误
+// 如果index == Len()则表示往List末端增加一个值
+func (g *GapBuffer[T]) Add(index int, t T) error {
+	return g.AddAll(index, t)
+}
+
+// AddAll 在特定下标处依次插入 ts，之后光标位于插入的最后一个元素之后
+// 如果下标不在[0, Len()]范围之内，应该返回错误
+func (g *GapBuffer[T]) AddAll(index int, ts ...T) error {
+	if index < 0 || index > g.Len() {
+		return errs.NewErrIndexOutOfRange(g.Len(), index)
+	}
+	g.moveGap(index)
+	g.grow(len(ts))
+	g.gapStart += copy(g.buf[g.gapStart:], ts)
+	return nil
+}
+
+// Set 重置 index 位置的值
+// 如果下标超出范围，应该返回错误
+func (g *GapBuffer[T]) Set(index int, t T) error {
+	if !g.checkIndex(index) {
+		return errs.NewErrIndexOutOfRange(g.Len(), index)
+	}
+	g.buf[g.physical(index)] = t
+	return nil
+}
+
+// Remove 删除目标元素的位置，并且返回该位置的值，之后光标位于 index 处
+// 如果 index 超出下标，应该返回错误
+func (g *GapBuffer[T]) Remove(index int) (T, error) {
+	if !g.checkIndex(index) {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(g.Len(), index)
+	}
+	t := g.buf[g.physical(index)]
+	g.removeRange(index, index+1)
+	return t, nil
+}
+
+// RemoveRange 删除 [from, to) 范围内的元素，之后光标位于 from 处
+// 如果不满足 0 <= from <= to <= Len()，应该返回错误
+func (g *GapBuffer[T]) RemoveRange(from, to int) error {
+	if err := checkRange(g.Len(), from, to); err != nil {
+		return err
+	}
+	g.removeRange(from, to)
+	return nil
+}
+
+func (g *GapBuffer[T]) removeRange(from, to int) {
+	g.moveGap(from)
+	clear(g.buf[g.gapEnd : g.gapEnd+to-from])
+	g.gapEnd += to - from
+}
+
+// Clear 删除所有元素，保留底层数组以便复用
+func (g *GapBuffer[T]) Clear() {
+	clear(g.buf)
+	g.gapStart, g.gapEnd = 0, len(g.buf)
+}
+
+// Len 返回长度
+func (g *GapBuffer[T]) Len() int {
+	return len(g.buf) - g.gapLen()
+}
+
+// Cap 返回容量
+func (g *GapBuffer[T]) Cap() int {
+	return len(g.buf)
+}
+
+// Range 遍历 List 的所有元素
+func (g *GapBuffer[T]) Range(fn func(index int, t T) error) error {
+	for i, t := range g.buf[:g.gapStart] {
+		if err := fn(i, t); err != nil {
+			return err
+		}
+	}
+	for i, t := range g.buf[g.gapEnd:] {
+		if err := fn(g.gapStart+i, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AsSlice 将 List 转化为一个切片
+// 不允许返回nil，在没有元素的情况下，
+// 必须返回一个长度和容量都为 0 的切片
+// AsSlice 每次调用都必须返回一个全新的切片
+func (g *GapBuffer[T]) AsSlice() []T {
+	res := make([]T, 0, g.Len())
+	res = append(res, g.buf[:g.gapStart]...)
+	return append(res, g.buf[g.gapEnd:]...)
+}
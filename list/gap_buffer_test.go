@@ -0,0 +1,162 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGapBuffer_Cursor(t *testing.T) {
+	g := NewGapBufferOf([]int{1, 2, 3, 4, 5})
+	assert.Equal(t, 5, g.Cursor())
+	assert.Equal(t, 5, g.Cap())
+
+	require.NoError(t, g.MoveCursor(2))
+	assert.Equal(t, 2, g.Cursor())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, g.AsSlice())
+
+	// 在光标处连续插入，光标随之后移
+	require.NoError(t, g.Add(2, 10))
+	require.NoError(t, g.Add(3, 11))
+	assert.Equal(t, 4, g.Cursor())
+	assert.Equal(t, []int{1, 2, 10, 11, 3, 4, 5}, g.AsSlice())
+
+	// 在光标之前删除（退格）
+	val, err := g.Remove(3)
+	require.NoError(t, err)
+	assert.Equal(t, 11, val)
+	assert.Equal(t, 3, g.Cursor())
+
+	require.NoError(t, g.MoveCursor(0))
+	require.NoError(t, g.MoveCursor(g.Len()))
+	assert.Equal(t, []int{1, 2, 10, 3, 4, 5}, g.AsSlice())
+
+	assert.Error(t, g.MoveCursor(-1))
+	assert.Error(t, g.MoveCursor(g.Len()+1))
+}
+
+func TestNewGapBuffer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		capacity int
+		wantCap  int
+	}{
+		{name: "正数容量", capacity: 8, wantCap: 8},
+		{name: "容量为 0", capacity: 0, wantCap: 0},
+		{name: "负数容量按 0 处理", capacity: -1, wantCap: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGapBuffer[int](tc.capacity)
+			assert.Equal(t, tc.wantCap, g.Cap())
+			assert.Equal(t, 0, g.Len())
+			assert.NoError(t, g.Append(1, 2))
+			assert.Equal(t, []int{1, 2}, g.AsSlice())
+		})
+	}
+}
+
+func TestGapBuffer_AddAll(t *testing.T) {
+	testCases := []struct {
+		name    string
+		src     []int
+		cursor  int
+		index   int
+		ts      []int
+		want    []int
+		wantErr bool
+	}{
+		{name: "空缓冲区", src: []int{}, index: 0, ts: []int{1, 2}, want: []int{1, 2}},
+		{name: "头部", src: []int{1, 2}, cursor: 2, index: 0, ts: []int{3, 4}, want: []int{3, 4, 1, 2}},
+		{name: "中间", src: []int{1, 2, 3}, cursor: 0, index: 2, ts: []int{4, 5}, want: []int{1, 2, 4, 5, 3}},
+		{name: "尾部", src: []int{1, 2}, cursor: 1, index: 2, ts: []int{3}, want: []int{1, 2, 3}},
+		{name: "插入空", src: []int{1, 2}, cursor: 1, index: 1, want: []int{1, 2}},
+		{name: "下标越界", src: []int{1, 2}, index: 3, ts: []int{3}, want: []int{1, 2}, wantErr: true},
+		{name: "负数下标", src: []int{1, 2}, index: -1, ts: []int{3}, want: []int{1, 2}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGapBufferOf(tc.src)
+			require.NoError(t, g.MoveCursor(tc.cursor))
+			err := g.AddAll(tc.index, tc.ts...)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.index+len(tc.ts), g.Cursor())
+			}
+			assert.Equal(t, tc.want, g.AsSlice())
+		})
+	}
+}
+
+func TestGapBuffer_RemoveRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		cursor   int
+		from, to int
+		want     []int
+		wantErr  bool
+	}{
+		{name: "光标之前", cursor: 5, from: 1, to: 3, want: []int{0, 3, 4}},
+		{name: "光标之后", cursor: 0, from: 2, to: 4, want: []int{0, 1, 4}},
+		{name: "跨越光标", cursor: 2, from: 1, to: 4, want: []int{0, 4}},
+		{name: "空范围", cursor: 2, from: 3, to: 3, want: []int{0, 1, 2, 3, 4}},
+		{name: "全部", cursor: 3, from: 0, to: 5, want: []int{}},
+		{name: "范围非法", cursor: 3, from: 3, to: 2, want: []int{0, 1, 2, 3, 4}, wantErr: true},
+		{name: "下标越界", cursor: 3, from: 3, to: 6, want: []int{0, 1, 2, 3, 4}, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGapBufferOf([]int{0, 1, 2, 3, 4})
+			require.NoError(t, g.MoveCursor(tc.cursor))
+			err := g.RemoveRange(tc.from, tc.to)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.from, g.Cursor())
+			}
+			assert.Equal(t, tc.want, g.AsSlice())
+		})
+	}
+}
+
+func TestGapBuffer_ClearReleasesElements(t *testing.T) {
+	x := 1
+	g := NewGapBufferOf([]*int{&x, &x, &x})
+	require.NoError(t, g.MoveCursor(1))
+	_, err := g.Remove(1)
+	require.NoError(t, err)
+	// 间隙中不能保留对已删除元素的引用
+	for i := g.gapStart; i < g.gapEnd; i++ {
+		assert.Nil(t, g.buf[i])
+	}
+	g.Clear()
+	assert.Equal(t, 0, g.Len())
+	assert.Equal(t, 3, g.Cap())
+	for _, p := range g.buf {
+		assert.Nil(t, p)
+	}
+}
+
+// BenchmarkList_AddCursor 模拟编辑器的工作负载：在缓慢移动的光标处连续插入
+func BenchmarkList_AddCursor(b *testing.B) {
+	for name, newList := range benchmarkLists() {
+		b.Run(name, func(b *testing.B) {
+			l := newBenchmarkList(newList, 10_000)
+			cursor := l.Len() / 2
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = l.Add(cursor, i)
+				cursor++
+				if i%64 == 63 {
+					// 每输入一段内容，光标移动到新的位置
+					cursor = (cursor * 7) % l.Len()
+				}
+			}
+		})
+	}
+}
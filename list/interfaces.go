@@ -29,3 +29,17 @@ type List[T any] interface {
 	// AsSlice 每次调用都必须返回一个全新的切片
 	AsSlice() []T
 }
+
+// ReadOnlyList 只读的 List，只包含按下标访问与遍历的方法
+// 所有的 List 都是 ReadOnlyList；SortedList 等不支持按下标写入的结构也可以实现该接口，
+// 从而可以直接使用 Equal、Diff、BinarySearch、IsSorted 等只读取元素的函数
+type ReadOnlyList[T any] interface {
+	// Get 返回对应下标的元素，在下标超出范围的情况下，返回错误
+	Get(index int) (T, error)
+	// Len 返回长度
+	Len() int
+	// Range 按照下标顺序遍历所有元素，fn 返回错误时停止遍历并返回该错误
+	Range(fn func(index int, t T) error) error
+	// AsSlice 将 List 转化为一个切片，约定与 List.AsSlice 相同
+	AsSlice() []T
+}
@@ -1,9 +1,6 @@
 package list
 
-import (
-	"fmt"
-	"mkit/internal/errs"
-)
+import "mkit/internal/errs"
 
 var (
 	_ List[any] = &LinkedList[any]{}
@@ -25,23 +22,61 @@ type LinkedList[T any] struct {
 	head   *node[T]
 	tail   *node[T]
 	length int
+
+	// free 是删除之后留待复用的节点组成的单链表，通过 next 连接
+	free     *node[T]
+	freeLen  int
+	maxFree  int
+	arena    []node[T]
+	arenaLen int
+}
+
+type linkedListOptions struct {
+	poolSize  int
+	arenaSize int
+}
+
+// LinkedListOption 创建 LinkedList 时的可选配置
+type LinkedListOption func(opts *linkedListOptions)
+
+// WithNodePool 缓存最多 size 个删除之后的节点，供之后的插入复用，减少内存分配
+// 适合频繁删除、插入且长度相对稳定的场景
+func WithNodePool(size int) LinkedListOption {
+	return func(opts *linkedListOptions) {
+		opts.poolSize = size
+	}
+}
+
+// WithNodeArena 每次分配一块可以容纳 size 个节点的连续内存，从中依次切分出新节点
+// 可以减少分配次数并且提高遍历时的局部性；
+// 但是一块内存只有在其中的节点全部不再被引用之后才能被回收
+func WithNodeArena(size int) LinkedListOption {
+	return func(opts *linkedListOptions) {
+		opts.arenaSize = size
+	}
 }
 
 // 创建一个新的链表
-func NewLinkedList[T any]() *LinkedList[T] {
+func NewLinkedList[T any](opts ...LinkedListOption) *LinkedList[T] {
+	var o linkedListOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	// 空链表包含头尾两个节点
 	head := &node[T]{}
 	tail := &node[T]{next: head, prev: head}
 	head.next, head.prev = tail, tail
 	return &LinkedList[T]{
-		head:   head,
-		tail:   tail,
-		length: 0,
+		head:     head,
+		tail:     tail,
+		length:   0,
+		maxFree:  max(o.poolSize, 0),
+		arenaLen: max(o.arenaSize, 0),
 	}
 }
 
-func NewLinkedListOf[T any](ts []T) *LinkedList[T] {
-	list := NewLinkedList[T]()
+func NewLinkedListOf[T any](ts []T, opts ...LinkedListOption) *LinkedList[T] {
+	list := NewLinkedList[T](opts...)
 	if err := list.Append(ts...); err != nil {
 		panic(err)
 	}
@@ -64,6 +99,53 @@ func (l *LinkedList[T]) findNode(index int) *node[T] {
 	return cur
 }
 
+// newNode 创建一个插入在 prev 和 next 之间的节点，优先复用缓存的节点
+func (l *LinkedList[T]) newNode(t T, prev, next *node[T]) *node[T] {
+	var n *node[T]
+	switch {
+	case l.free != nil:
+		n, l.free = l.free, l.free.next
+		l.freeLen--
+	case l.arenaLen > 0:
+		if len(l.arena) == 0 {
+			l.arena = make([]node[T], l.arenaLen)
+		}
+		n, l.arena = &l.arena[0], l.arena[1:]
+	default:
+		n = &node[T]{}
+	}
+	n.val, n.prev, n.next = t, prev, next
+	prev.next, next.prev = n, n
+	return n
+}
+
+// releaseNode 缓存已经从链表中摘下的节点，缓存已满时交给 GC 回收
+// 无论是否缓存都会清空节点中的值和指针：arena 中的节点要等到整块内存都不再被引用时才会被回收，
+// 不清空的话，已经删除的元素会一直被引用
+func (l *LinkedList[T]) releaseNode(n *node[T]) {
+	var zeroVal T
+	n.val, n.prev, n.next = zeroVal, nil, nil
+	if l.freeLen >= l.maxFree {
+		return
+	}
+	n.next = l.free
+	l.free = n
+	l.freeLen++
+}
+
+// releaseNodes 缓存 [first, last] 之间的节点，调用者需要保证它们已经从链表中摘下
+// 不使用 arena 时，缓存已满之后剩余的节点直接交给 GC 回收，不需要逐个清空
+func (l *LinkedList[T]) releaseNodes(first, last *node[T]) {
+	for cur := first; l.arenaLen > 0 || l.freeLen < l.maxFree; {
+		next := cur.next
+		l.releaseNode(cur)
+		if cur == last {
+			return
+		}
+		cur = next
+	}
+}
+
 func (l *LinkedList[T]) checkIndex(index int) bool {
 	return 0 <= index && index < l.Len()
 }
@@ -79,10 +161,8 @@ func (l *LinkedList[T]) Get(index int) (T, error) {
 }
 
 func (l *LinkedList[T]) Append(ts ...T) error {
-	fmt.Println(ts)
 	for _, t := range ts {
-		node := &node[T]{prev: l.tail.prev, next: l.tail, val: t}
-		node.prev.next, node.next.prev = node, node
+		l.newNode(t, l.tail.prev, l.tail)
 		l.length++
 	}
 	return nil
@@ -101,8 +181,7 @@ func (l *LinkedList[T]) Add(index int, t T) error {
 	nextNode := l.findNode(index)
 
 	// 创建新节点，然后插入链表对应位置
-	node := &node[T]{prev: nextNode.prev, next: nextNode, val: t}
-	node.prev.next, node.next.prev = node, node
+	l.newNode(t, nextNode.prev, nextNode)
 	l.length++
 	return nil
 }
@@ -125,7 +204,9 @@ func (l *LinkedList[T]) Remove(index int) (T, error) {
 	node := l.findNode(index)
 	node.prev.next, node.next.prev = node.next, node.prev
 	l.length--
-	return node.val, nil
+	val := node.val
+	l.releaseNode(node)
+	return val, nil
 }
 
 func (l *LinkedList[T]) Len() int {
@@ -156,3 +237,186 @@ func (l *LinkedList[T]) AsSlice() []T {
 	}
 	return ans
 }
+
+// Sort 使用 cmp 对链表原地排序
+// 链表使用归并排序实现，本身就是稳定的，因此与 SortStable 等价
+func (l *LinkedList[T]) Sort(cmp func(a, b T) int) {
+	l.SortStable(cmp)
+}
+
+// SortStable 使用 cmp 对链表原地进行稳定排序
+// 直接在节点上做归并排序，只调整指针，不会转化为切片
+func (l *LinkedList[T]) SortStable(cmp func(a, b T) int) {
+	if l.length < 2 {
+		return
+	}
+
+	// 先把真实节点摘下来作为以 nil 结尾的单链表，排序后再恢复 prev 指针
+	first := l.head.next
+	l.tail.prev.next = nil
+	first = mergeSort(first, l.length, cmp)
+
+	prev := l.head
+	for cur := first; cur != nil; cur = cur.next {
+		prev.next, cur.prev = cur, prev
+		prev = cur
+	}
+	prev.next, l.tail.prev = l.tail, prev
+}
+
+// mergeSort 对以 head 开头、长度为 n 的单链表做归并排序，返回排序后的头节点
+func mergeSort[T any](head *node[T], n int, cmp func(a, b T) int) *node[T] {
+	if n < 2 {
+		return head
+	}
+
+	mid := head
+	for i := 1; i < n/2; i++ {
+		mid = mid.next
+	}
+	right := mid.next
+	mid.next = nil
+
+	left := mergeSort(head, n/2, cmp)
+	right = mergeSort(right, n-n/2, cmp)
+
+	// 相等时优先取左侧节点，保证排序稳定
+	dummy := &node[T]{}
+	tail := dummy
+	for left != nil && right != nil {
+		if cmp(left.val, right.val) <= 0 {
+			tail.next, left = left, left.next
+		} else {
+			tail.next, right = right, right.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return dummy.next
+}
+
+// Reverse 原地反转链表，只交换节点的指针
+func (l *LinkedList[T]) Reverse() {
+	if l.length < 2 {
+		return
+	}
+
+	first, last := l.head.next, l.tail.prev
+	for cur := first; cur != l.tail; {
+		next := cur.next
+		cur.prev, cur.next = cur.next, cur.prev
+		cur = next
+	}
+	first.next, last.prev = l.tail, l.head
+	l.head.next, l.tail.prev = last, first
+}
+
+// Swap 交换 i 和 j 位置的元素
+// 如果下标超出范围，应该返回错误
+func (l *LinkedList[T]) Swap(i, j int) error {
+	if !l.checkIndex(i) {
+		return errs.NewErrIndexOutOfRange(l.Len(), i)
+	}
+	if !l.checkIndex(j) {
+		return errs.NewErrIndexOutOfRange(l.Len(), j)
+	}
+	if i == j {
+		return nil
+	}
+	a, b := l.findNode(i), l.findNode(j)
+	a.val, b.val = b.val, a.val
+	return nil
+}
+
+// AddAll 在特定下标处依次插入 ts，只定位一次插入位置
+// 如果下标不在[0, Len()]范围之内，应该返回错误
+func (l *LinkedList[T]) AddAll(index int, ts ...T) error {
+	if index < 0 || index > l.Len() {
+		return errs.NewErrIndexOutOfRange(l.Len(), index)
+	}
+
+	nextNode := l.tail
+	if index < l.Len() {
+		nextNode = l.findNode(index)
+	}
+	for _, t := range ts {
+		l.newNode(t, nextNode.prev, nextNode)
+	}
+	l.length += len(ts)
+	return nil
+}
+
+// RemoveIf 删除所有满足 pred 的元素，返回被删除的元素个数
+func (l *LinkedList[T]) RemoveIf(pred func(t T) bool) int {
+	removed := 0
+	for cur := l.head.next; cur != l.tail; {
+		next := cur.next
+		if pred(cur.val) {
+			cur.prev.next, cur.next.prev = cur.next, cur.prev
+			l.releaseNode(cur)
+			removed++
+		}
+		cur = next
+	}
+	l.length -= removed
+	return removed
+}
+
+// RetainAll 只保留满足 pred 的元素，返回被删除的元素个数
+func (l *LinkedList[T]) RetainAll(pred func(t T) bool) int {
+	return l.RemoveIf(func(t T) bool {
+		return !pred(t)
+	})
+}
+
+// RemoveRange 删除 [from, to) 范围内的元素
+// 如果不满足 0 <= from <= to <= Len()，应该返回错误
+func (l *LinkedList[T]) RemoveRange(from, to int) error {
+	if err := checkRange(l.Len(), from, to); err != nil {
+		return err
+	}
+	if from == to {
+		return nil
+	}
+
+	first := l.findNode(from)
+	last := first
+	for i := from + 1; i < to; i++ {
+		last = last.next
+	}
+	first.prev.next, last.next.prev = last.next, first.prev
+	l.length -= to - from
+	l.releaseNodes(first, last)
+	return nil
+}
+
+// Clear 删除所有元素
+func (l *LinkedList[T]) Clear() {
+	first, last := l.head.next, l.tail.prev
+	l.head.next, l.tail.prev = l.tail, l.head
+	if l.length > 0 {
+		l.releaseNodes(first, last)
+	}
+	l.length = 0
+}
+
+// IndexOf 返回第一个与 t 相等的元素的下标，不存在时返回 -1
+func (l *LinkedList[T]) IndexOf(t T, equal func(src, dst T) bool) int {
+	i := 0
+	for cur := l.head.next; cur != l.tail; cur = cur.next {
+		if equal(cur.val, t) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Contains 判断链表中是否存在与 t 相等的元素
+func (l *LinkedList[T]) Contains(t T, equal func(src, dst T) bool) bool {
+	return l.IndexOf(t, equal) >= 0
+}
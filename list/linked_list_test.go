@@ -1,101 +1,224 @@
 package list
 
 import (
+	"strings"
 	"testing"
+	"unsafe"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func TestLinkedList_Basic(t *testing.T) {
-	ll := NewLinkedList[int]()
-	assert.Equal(t, 0, ll.Len())
-	assert.Equal(t, 0, ll.Cap())
-	assert.Equal(t, []int{}, ll.AsSlice())
+// List 接口约定的基本行为（Append、Add、Get、Set、Remove、Range、AsSlice 等）
+// 由 conformance_test.go 中的 listtest 覆盖，这里只测试 LinkedList 特有的行为
 
-	// Append
-	err := ll.Append(1, 2, 3)
-	assert.NoError(t, err)
+func TestLinkedList_NewLinkedListOf(t *testing.T) {
+	ll := NewLinkedListOf([]int{5, 6, 7})
 	assert.Equal(t, 3, ll.Len())
-	assert.Equal(t, []int{1, 2, 3}, ll.AsSlice())
-
-	// Add at head
-	err = ll.Add(0, 10)
-	assert.NoError(t, err)
-	assert.Equal(t, []int{10, 1, 2, 3}, ll.AsSlice())
-
-	// Add at tail
-	err = ll.Add(ll.Len(), 20)
-	assert.NoError(t, err)
-	assert.Equal(t, []int{10, 1, 2, 3, 20}, ll.AsSlice())
+	// 链表没有预留的空间，容量总是等于长度
+	assert.Equal(t, 3, ll.Cap())
+	assert.Equal(t, []int{5, 6, 7}, ll.AsSlice())
+}
 
-	// Add at middle
-	err = ll.Add(2, 99)
-	assert.NoError(t, err)
-	assert.Equal(t, []int{10, 1, 99, 2, 3, 20}, ll.AsSlice())
+func TestLinkedList_AddAll(t *testing.T) {
+	testCases := []struct {
+		name    string
+		index   int
+		ts      []int
+		want    []int
+		wantErr bool
+	}{
+		{name: "头部插入", index: 0, ts: []int{7, 8}, want: []int{7, 8, 1, 2, 3}},
+		{name: "中间插入", index: 1, ts: []int{7, 8}, want: []int{1, 7, 8, 2, 3}},
+		{name: "尾部插入", index: 3, ts: []int{7, 8}, want: []int{1, 2, 3, 7, 8}},
+		{name: "插入空元素", index: 1, ts: nil, want: []int{1, 2, 3}},
+		{name: "下标越界", index: -1, ts: []int{7}, want: []int{1, 2, 3}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ll := NewLinkedListOf([]int{1, 2, 3})
+			err := ll.AddAll(tc.index, tc.ts...)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, ll.AsSlice())
+			assert.Equal(t, len(tc.want), ll.Len())
+		})
+	}
+}
 
-	// Get
-	val, err := ll.Get(2)
-	assert.NoError(t, err)
-	assert.Equal(t, 99, val)
+func TestLinkedList_RemoveIf(t *testing.T) {
+	ll := NewLinkedListOf([]int{1, 2, 3, 4, 5, 6})
+	assert.Equal(t, 3, ll.RemoveIf(func(t int) bool { return t%2 == 0 }))
+	assert.Equal(t, []int{1, 3, 5}, ll.AsSlice())
+	assert.Equal(t, 3, ll.Len())
 
-	// Set
-	err = ll.Set(2, 100)
-	assert.NoError(t, err)
-	val, err = ll.Get(2)
-	assert.NoError(t, err)
-	assert.Equal(t, 100, val)
+	assert.Equal(t, 1, ll.RetainAll(func(t int) bool { return t > 1 }))
+	assert.Equal(t, []int{3, 5}, ll.AsSlice())
 
-	// Remove head
-	removed, err := ll.Remove(0)
-	assert.NoError(t, err)
-	assert.Equal(t, 10, removed)
-	assert.Equal(t, []int{1, 100, 2, 3, 20}, ll.AsSlice())
+	assert.Equal(t, 2, ll.RemoveIf(func(t int) bool { return true }))
+	assert.Equal(t, []int{}, ll.AsSlice())
+	assert.NoError(t, ll.Append(9))
+	assert.Equal(t, []int{9}, ll.AsSlice())
+}
 
-	// Remove tail
-	removed, err = ll.Remove(ll.Len() - 1)
-	assert.NoError(t, err)
-	assert.Equal(t, 20, removed)
-	assert.Equal(t, []int{1, 100, 2, 3}, ll.AsSlice())
+func TestLinkedList_RemoveRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int
+		want     []int
+		wantErr  bool
+	}{
+		{name: "删除头部", from: 0, to: 2, want: []int{3, 4, 5}},
+		{name: "删除中间", from: 1, to: 4, want: []int{1, 5}},
+		{name: "删除尾部", from: 3, to: 5, want: []int{1, 2, 3}},
+		{name: "删除全部", from: 0, to: 5, want: []int{}},
+		{name: "空区间", from: 5, to: 5, want: []int{1, 2, 3, 4, 5}},
+		{name: "from越界", from: -1, to: 2, want: []int{1, 2, 3, 4, 5}, wantErr: true},
+		{name: "to越界", from: 0, to: 6, want: []int{1, 2, 3, 4, 5}, wantErr: true},
+		{name: "from大于to", from: 3, to: 2, want: []int{1, 2, 3, 4, 5}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ll := NewLinkedListOf([]int{1, 2, 3, 4, 5})
+			err := ll.RemoveRange(tc.from, tc.to)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.want, ll.AsSlice())
+			assert.Equal(t, len(tc.want), ll.Len())
+		})
+	}
+}
 
-	// Remove middle
-	removed, err = ll.Remove(1)
-	assert.NoError(t, err)
-	assert.Equal(t, 100, removed)
-	assert.Equal(t, []int{1, 2, 3}, ll.AsSlice())
+func TestLinkedList_Clear(t *testing.T) {
+	ll := NewLinkedListOf([]int{1, 2, 3})
+	ll.Clear()
+	assert.Equal(t, 0, ll.Len())
+	assert.Equal(t, []int{}, ll.AsSlice())
+	assert.NoError(t, ll.Add(0, 4))
+	assert.Equal(t, []int{4}, ll.AsSlice())
 }
 
-func TestLinkedList_Errors(t *testing.T) {
-	ll := NewLinkedList[int]()
-	_, err := ll.Get(0)
-	assert.Error(t, err)
+func TestLinkedList_IndexOf(t *testing.T) {
+	ll := NewLinkedListOf([]string{"Go", "Java", "go"})
+	equalFold := func(src, dst string) bool { return strings.EqualFold(src, dst) }
+	assert.Equal(t, 0, ll.IndexOf("GO", equalFold))
+	assert.Equal(t, 1, ll.IndexOf("JAVA", equalFold))
+	assert.Equal(t, -1, ll.IndexOf("rust", equalFold))
+	assert.True(t, ll.Contains("java", equalFold))
+	assert.False(t, ll.Contains("c", equalFold))
+}
 
-	err = ll.Set(0, 1)
-	assert.Error(t, err)
+func TestLinkedList_NodePool(t *testing.T) {
+	ll := NewLinkedListOf([]int{1, 2, 3, 4, 5}, WithNodePool(2))
+	removed := ll.findNode(1)
+	_, err := ll.Remove(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, ll.freeLen)
+
+	// 删除的节点会被下一次插入复用
+	assert.NoError(t, ll.Add(0, 10))
+	assert.Same(t, removed, ll.findNode(0))
+	assert.Equal(t, 0, ll.freeLen)
+
+	// 缓存的节点数量不超过上限
+	assert.Equal(t, 3, ll.RemoveIf(func(t int) bool { return t%2 == 1 }))
+	assert.Equal(t, []int{10, 4}, ll.AsSlice())
+	assert.NoError(t, ll.RemoveRange(0, 1))
+	assert.Equal(t, 2, ll.freeLen)
+	ll.Clear()
+	assert.Equal(t, 2, ll.freeLen)
+	assert.Equal(t, []int{}, ll.AsSlice())
 
-	_, err = ll.Remove(0)
-	assert.Error(t, err)
+	assert.NoError(t, ll.Append(6, 7, 8))
+	assert.Equal(t, []int{6, 7, 8}, ll.AsSlice())
+	assert.Equal(t, 0, ll.freeLen)
+}
 
-	err = ll.Add(-1, 1)
-	assert.Error(t, err)
+func TestLinkedList_NodePoolReleasesValues(t *testing.T) {
+	x := 1
+	ll := NewLinkedListOf([]*int{&x, &x, &x}, WithNodePool(3))
+	ll.Clear()
+	for cur := ll.free; cur != nil; cur = cur.next {
+		assert.Nil(t, cur.val)
+		assert.Nil(t, cur.prev)
+	}
+}
 
-	err = ll.Add(1, 1)
-	assert.Error(t, err)
+func TestLinkedList_NodeArena(t *testing.T) {
+	ll := NewLinkedList[int](WithNodeArena(4))
+	assert.NoError(t, ll.Append(1, 2, 3))
+	// 同一块内存中的节点是连续分配的
+	chunk := unsafe.Slice(ll.findNode(0), 4)
+	assert.Same(t, ll.findNode(2), &chunk[2])
+	assert.Same(t, &ll.arena[0], &chunk[3])
+	assert.Len(t, ll.arena, 1)
+	assert.NoError(t, ll.Append(4, 5))
+	assert.Len(t, ll.arena, 3)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ll.AsSlice())
 }
 
-func TestLinkedList_Range(t *testing.T) {
-	ll := NewLinkedList[int]()
-	ll.Append(1, 2, 3)
-	var res []int
-	err := ll.Range(func(index int, t int) error {
-		res = append(res, t)
-		return nil
-	})
-	assert.NoError(t, err)
-	assert.Equal(t, []int{1, 2, 3}, res)
+// arena 中被删除的节点不再引用原来的元素，即使节点没有被缓存
+func TestLinkedList_NodeArenaReleasesValues(t *testing.T) {
+	x := 1
+	testCases := []struct {
+		name   string
+		remove func(ll *LinkedList[*int])
+	}{
+		{name: "Remove", remove: func(ll *LinkedList[*int]) {
+			for ll.Len() > 0 {
+				_, _ = ll.Remove(0)
+			}
+		}},
+		{name: "RemoveIf", remove: func(ll *LinkedList[*int]) { ll.RemoveIf(func(*int) bool { return true }) }},
+		{name: "RemoveRange", remove: func(ll *LinkedList[*int]) { _ = ll.RemoveRange(0, ll.Len()) }},
+		{name: "Clear", remove: func(ll *LinkedList[*int]) { ll.Clear() }},
+	}
+	for _, tc := range testCases {
+		for _, opts := range [][]LinkedListOption{
+			{WithNodeArena(8)},
+			{WithNodeArena(8), WithNodePool(2)},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				ll := NewLinkedList[*int](opts...)
+				assert.NoError(t, ll.Append(&x, &x, &x, &x, &x))
+				chunk := unsafe.Slice(ll.findNode(0), 8)
+				tc.remove(ll)
+				for i := 0; i < 5; i++ {
+					assert.Nil(t, chunk[i].val, "index = %d", i)
+					assert.Nil(t, chunk[i].prev, "index = %d", i)
+				}
+			})
+		}
+	}
 }
 
-func TestLinkedList_NewLinkedListOf(t *testing.T) {
-	ll := NewLinkedListOf([]int{5, 6, 7})
-	assert.Equal(t, 3, ll.Len())
-	assert.Equal(t, []int{5, 6, 7}, ll.AsSlice())
+// BenchmarkLinkedList_Churn 在长度稳定的链表上反复删除、插入，对比节点复用的效果
+func BenchmarkLinkedList_Churn(b *testing.B) {
+	testCases := []struct {
+		name string
+		opts []LinkedListOption
+	}{
+		{name: "Default"},
+		{name: "NodePool", opts: []LinkedListOption{WithNodePool(64)}},
+		{name: "NodeArena", opts: []LinkedListOption{WithNodeArena(64)}},
+	}
+	for _, tc := range testCases {
+		b.Run(tc.name, func(b *testing.B) {
+			ll := NewLinkedList[int](tc.opts...)
+			_ = ll.Append(make([]int, 1000)...)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = ll.Remove(0)
+				_ = ll.Append(i)
+			}
+		})
+	}
 }
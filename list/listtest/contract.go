@@ -0,0 +1,190 @@
+package listtest
+
+import (
+	"errors"
+
+	"mkit/list"
+)
+
+type contractCase[T any] struct {
+	name string
+	run  func(s *suite[T], l list.List[T])
+}
+
+// contractCases 返回 list.List 文档中约定的行为对应的测试用例
+func contractCases[T any]() []contractCase[T] {
+	return []contractCase[T]{
+		{
+			name: "空列表",
+			run: func(s *suite[T], l list.List[T]) {
+				s.assertState(l, []T{})
+			},
+		},
+		{
+			name: "Append",
+			run: func(s *suite[T], l list.List[T]) {
+				want := s.gen(5)
+				s.noError(l.Append(want[:2]...), "Append")
+				s.noError(l.Append(), "Append()")
+				s.noError(l.Append(want[2:]...), "Append")
+				s.assertState(l, want)
+			},
+		},
+		{
+			name: "Add",
+			run: func(s *suite[T], l list.List[T]) {
+				ts := s.gen(4)
+				s.noError(l.Add(0, ts[1]), "Add(0) 空列表")
+				s.noError(l.Add(0, ts[0]), "Add(0) 头部")
+				s.noError(l.Add(l.Len(), ts[3]), "Add(Len()) 尾部")
+				s.noError(l.Add(2, ts[2]), "Add(2) 中间")
+				s.assertState(l, ts)
+			},
+		},
+		{
+			name: "Add 下标越界",
+			run: func(s *suite[T], l list.List[T]) {
+				want := s.gen(2)
+				s.noError(l.Append(want...), "Append")
+				s.error(l.Add(-1, s.cfg.Gen(s.r)), "Add(-1)")
+				s.error(l.Add(3, s.cfg.Gen(s.r)), "Add(Len()+1)")
+				s.assertState(l, want)
+			},
+		},
+		{
+			name: "Get",
+			run: func(s *suite[T], l list.List[T]) {
+				want := s.gen(3)
+				s.noError(l.Append(want...), "Append")
+				for i, w := range want {
+					v, err := l.Get(i)
+					s.noError(err, "Get")
+					s.equal(w, v, "Get")
+				}
+				for _, index := range []int{-1, 3} {
+					_, err := l.Get(index)
+					s.error(err, "Get 下标越界")
+				}
+			},
+		},
+		{
+			name: "Set",
+			run: func(s *suite[T], l list.List[T]) {
+				want := s.gen(3)
+				s.noError(l.Append(s.gen(3)...), "Append")
+				for i, w := range want {
+					s.noError(l.Set(i, w), "Set")
+				}
+				s.error(l.Set(-1, s.cfg.Gen(s.r)), "Set(-1)")
+				s.error(l.Set(3, s.cfg.Gen(s.r)), "Set(Len())")
+				s.assertState(l, want)
+			},
+		},
+		{
+			name: "Remove",
+			run: func(s *suite[T], l list.List[T]) {
+				ts := s.gen(5)
+				s.noError(l.Append(ts...), "Append")
+				for _, index := range []int{4, 0, 1} {
+					v, err := l.Remove(index)
+					s.noError(err, "Remove")
+					s.equal(ts[index], v, "Remove 返回值")
+					ts = append(ts[:index], ts[index+1:]...)
+				}
+				s.assertState(l, ts)
+			},
+		},
+		{
+			name: "Remove 下标越界",
+			run: func(s *suite[T], l list.List[T]) {
+				_, err := l.Remove(0)
+				s.error(err, "空列表 Remove(0)")
+				want := s.gen(2)
+				s.noError(l.Append(want...), "Append")
+				_, err = l.Remove(-1)
+				s.error(err, "Remove(-1)")
+				_, err = l.Remove(2)
+				s.error(err, "Remove(Len())")
+				s.assertState(l, want)
+			},
+		},
+		{
+			name: "Range 提前退出",
+			run: func(s *suite[T], l list.List[T]) {
+				s.noError(l.Append(s.gen(5)...), "Append")
+				errStop := errors.New("stop")
+				visited := 0
+				err := l.Range(func(index int, t T) error {
+					visited++
+					if index == 2 {
+						return errStop
+					}
+					return nil
+				})
+				if !errors.Is(err, errStop) {
+					s.t.Errorf("Range 应该返回回调函数的错误，实际为 %v", err)
+				}
+				if visited != 3 {
+					s.t.Errorf("Range 在回调函数返回错误后应该停止遍历，实际遍历了 %d 个元素", visited)
+				}
+			},
+		},
+		{
+			name: "AsSlice 返回全新的切片",
+			run: func(s *suite[T], l list.List[T]) {
+				want := s.gen(3)
+				s.noError(l.Append(want...), "Append")
+				first, second := l.AsSlice(), l.AsSlice()
+				if &first[0] == &second[0] {
+					s.t.Error("AsSlice 每次调用都必须返回一个全新的切片")
+				}
+				// 修改返回的切片不能影响 List 本身
+				first[0] = s.cfg.Gen(s.r)
+				s.assertState(l, want)
+			},
+		},
+		{
+			name: "删除全部元素后可以继续使用",
+			run: func(s *suite[T], l list.List[T]) {
+				s.noError(l.Append(s.gen(3)...), "Append")
+				for l.Len() > 0 {
+					_, err := l.Remove(l.Len() - 1)
+					s.noError(err, "Remove")
+				}
+				s.assertState(l, []T{})
+				want := s.gen(2)
+				s.noError(l.Add(0, want[1]), "Add")
+				s.noError(l.Add(0, want[0]), "Add")
+				s.assertState(l, want)
+			},
+		},
+	}
+}
+
+func (s *suite[T]) assertState(l list.List[T], want []T) {
+	s.t.Helper()
+	if msg := s.checkState(l, want); msg != "" {
+		s.t.Error(msg)
+	}
+}
+
+func (s *suite[T]) noError(err error, op string) {
+	s.t.Helper()
+	if err != nil {
+		s.t.Fatalf("%s 返回错误: %v", op, err)
+	}
+}
+
+func (s *suite[T]) error(err error, op string) {
+	s.t.Helper()
+	if err == nil {
+		s.t.Errorf("%s 应该返回错误", op)
+	}
+}
+
+func (s *suite[T]) equal(want, got T, op string) {
+	s.t.Helper()
+	if !s.cfg.Equal(want, got) {
+		s.t.Errorf("%s 结果为 %v，期望 %v", op, got, want)
+	}
+}
@@ -0,0 +1,254 @@
+// Package listtest 提供 list.List 接口的一致性测试套件
+// 任何 List 实现（包括第三方实现）都可以通过一次 Run 调用，
+// 验证自己是否满足 list.List 中约定的行为
+package listtest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"mkit/list"
+)
+
+// Config 描述待测试的 List 实现
+type Config[T any] struct {
+	// New 创建一个空的 List，必须设置
+	New func() list.List[T]
+	// Gen 使用 r 生成一个随机元素，必须设置
+	Gen func(r *rand.Rand) T
+	// Equal 判断两个元素是否相等，默认使用 reflect.DeepEqual
+	Equal func(a, b T) bool
+	// Seed 随机数种子，为 0 时使用当前时间
+	// 测试失败时会输出使用的种子，便于复现
+	Seed int64
+	// Rounds 随机操作序列的轮数，默认 50
+	Rounds int
+	// Steps 每一轮随机操作的次数，默认 200
+	Steps int
+}
+
+// Run 依次运行表格驱动的契约测试以及基于随机操作序列的性质测试
+func Run[T any](t *testing.T, cfg Config[T]) {
+	t.Helper()
+	cfg = cfg.withDefaults(t)
+	t.Run("Contract", func(t *testing.T) {
+		RunContract(t, cfg)
+	})
+	t.Run("Property", func(t *testing.T) {
+		RunProperty(t, cfg)
+	})
+}
+
+func (cfg Config[T]) withDefaults(t *testing.T) Config[T] {
+	t.Helper()
+	if cfg.New == nil || cfg.Gen == nil {
+		t.Fatal("listtest: 必须设置 Config.New 和 Config.Gen")
+	}
+	if cfg.Equal == nil {
+		cfg.Equal = func(a, b T) bool {
+			return reflect.DeepEqual(a, b)
+		}
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	if cfg.Rounds <= 0 {
+		cfg.Rounds = 50
+	}
+	if cfg.Steps <= 0 {
+		cfg.Steps = 200
+	}
+	return cfg
+}
+
+// RunContract 运行表格驱动的契约测试，每个用例都从一个新的空 List 开始
+func RunContract[T any](t *testing.T, cfg Config[T]) {
+	t.Helper()
+	cfg = cfg.withDefaults(t)
+	for _, tc := range contractCases[T]() {
+		t.Run(tc.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(cfg.Seed))
+			s := &suite[T]{t: t, cfg: cfg, r: r}
+			defer s.logSeedOnFailure()
+			tc.run(s, cfg.New())
+		})
+	}
+}
+
+// RunProperty 对 List 执行随机的操作序列，并在每一步之后与参考模型（切片）比较
+func RunProperty[T any](t *testing.T, cfg Config[T]) {
+	t.Helper()
+	cfg = cfg.withDefaults(t)
+	r := rand.New(rand.NewSource(cfg.Seed))
+	for round := 0; round < cfg.Rounds; round++ {
+		s := &suite[T]{t: t, cfg: cfg, r: r}
+		if !s.runRandomOps(round, cfg.New()) {
+			return
+		}
+	}
+}
+
+type suite[T any] struct {
+	t   *testing.T
+	cfg Config[T]
+	r   *rand.Rand
+}
+
+func (s *suite[T]) logSeedOnFailure() {
+	if s.t.Failed() {
+		s.t.Logf("listtest: 随机数种子 Seed = %d", s.cfg.Seed)
+	}
+}
+
+func (s *suite[T]) gen(n int) []T {
+	res := make([]T, n)
+	for i := range res {
+		res[i] = s.cfg.Gen(s.r)
+	}
+	return res
+}
+
+func (s *suite[T]) equalSlice(a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !s.cfg.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkState 检查 l 的所有只读方法是否都与 want 一致，返回不一致的描述
+func (s *suite[T]) checkState(l list.List[T], want []T) string {
+	if l.Len() != len(want) {
+		return fmt.Sprintf("Len() = %d，期望 %d", l.Len(), len(want))
+	}
+	if l.Cap() < l.Len() {
+		return fmt.Sprintf("Cap() = %d 小于 Len() = %d", l.Cap(), l.Len())
+	}
+
+	got := l.AsSlice()
+	if got == nil {
+		return "AsSlice() 返回了 nil"
+	}
+	if len(got) == 0 && cap(got) != 0 {
+		return fmt.Sprintf("没有元素时 AsSlice() 的容量为 %d，期望为 0", cap(got))
+	}
+	if !s.equalSlice(got, want) {
+		return fmt.Sprintf("AsSlice() = %v，期望 %v", got, want)
+	}
+
+	ranged := make([]T, 0, len(want))
+	err := l.Range(func(index int, t T) error {
+		if index != len(ranged) {
+			return fmt.Errorf("Range 传入的下标为 %d，期望 %d", index, len(ranged))
+		}
+		ranged = append(ranged, t)
+		return nil
+	})
+	if err != nil {
+		return err.Error()
+	}
+	if !s.equalSlice(ranged, want) {
+		return fmt.Sprintf("Range 遍历结果为 %v，期望 %v", ranged, want)
+	}
+	return ""
+}
+
+// runRandomOps 执行一轮随机操作，返回 false 表示已经失败
+func (s *suite[T]) runRandomOps(round int, l list.List[T]) bool {
+	model := make([]T, 0)
+	history := make([]string, 0, s.cfg.Steps)
+
+	fail := func(format string, args ...any) bool {
+		s.t.Errorf("listtest: 第 %d 轮第 %d 步失败，Seed = %d\n操作序列:\n  %s\n%s",
+			round, len(history), s.cfg.Seed, strings.Join(history, "\n  "), fmt.Sprintf(format, args...))
+		return false
+	}
+
+	for step := 0; step < s.cfg.Steps; step++ {
+		// 下标有一定概率越界，用来验证错误处理
+		index := s.r.Intn(len(model)+3) - 1
+		valid := index >= 0 && index < len(model)
+
+		switch op := s.r.Intn(5); op {
+		case 0:
+			ts := s.gen(s.r.Intn(4))
+			history = append(history, fmt.Sprintf("Append(%v)", ts))
+			if err := l.Append(ts...); err != nil {
+				return fail("Append 返回错误: %v", err)
+			}
+			model = append(model, ts...)
+		case 1:
+			t := s.cfg.Gen(s.r)
+			history = append(history, fmt.Sprintf("Add(%d, %v)", index, t))
+			err := l.Add(index, t)
+			if index < 0 || index > len(model) {
+				if err == nil {
+					return fail("Add(%d) 下标越界时应该返回错误", index)
+				}
+				break
+			}
+			if err != nil {
+				return fail("Add(%d) 返回错误: %v", index, err)
+			}
+			model = append(model[:index], append([]T{t}, model[index:]...)...)
+		case 2:
+			t := s.cfg.Gen(s.r)
+			history = append(history, fmt.Sprintf("Set(%d, %v)", index, t))
+			err := l.Set(index, t)
+			if !valid {
+				if err == nil {
+					return fail("Set(%d) 下标越界时应该返回错误", index)
+				}
+				break
+			}
+			if err != nil {
+				return fail("Set(%d) 返回错误: %v", index, err)
+			}
+			model[index] = t
+		case 3:
+			history = append(history, fmt.Sprintf("Remove(%d)", index))
+			v, err := l.Remove(index)
+			if !valid {
+				if err == nil {
+					return fail("Remove(%d) 下标越界时应该返回错误", index)
+				}
+				break
+			}
+			if err != nil {
+				return fail("Remove(%d) 返回错误: %v", index, err)
+			}
+			if !s.cfg.Equal(v, model[index]) {
+				return fail("Remove(%d) = %v，期望 %v", index, v, model[index])
+			}
+			model = append(model[:index], model[index+1:]...)
+		case 4:
+			history = append(history, fmt.Sprintf("Get(%d)", index))
+			v, err := l.Get(index)
+			if !valid {
+				if err == nil {
+					return fail("Get(%d) 下标越界时应该返回错误", index)
+				}
+				break
+			}
+			if err != nil {
+				return fail("Get(%d) 返回错误: %v", index, err)
+			}
+			if !s.cfg.Equal(v, model[index]) {
+				return fail("Get(%d) = %v，期望 %v", index, v, model[index])
+			}
+		}
+
+		if msg := s.checkState(l, model); msg != "" {
+			return fail("%s", msg)
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package listtest
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+
+	"mkit/list"
+)
+
+var errIndex = errors.New("listtest: 下标超出范围")
+
+// sliceList 是基于切片的 List，bug 不为空时会故意违反 list.List 的约定，用于验证测试套件本身
+type sliceList struct {
+	elems []int
+	bug   string
+}
+
+func (l *sliceList) Get(index int) (int, error) {
+	if index < 0 || index >= len(l.elems) {
+		return 0, errIndex
+	}
+	return l.elems[index], nil
+}
+
+func (l *sliceList) Append(ts ...int) error {
+	if l.bug == "AppendDropsLast" && len(ts) > 1 {
+		ts = ts[:len(ts)-1]
+	}
+	l.elems = append(l.elems, ts...)
+	return nil
+}
+
+func (l *sliceList) Add(index int, t int) error {
+	if index < 0 || index > len(l.elems) {
+		return errIndex
+	}
+	if l.bug == "AddOffByOne" && index < len(l.elems) {
+		index++
+	}
+	l.elems = slices.Insert(l.elems, index, t)
+	return nil
+}
+
+func (l *sliceList) Set(index int, t int) error {
+	if index < 0 || index >= len(l.elems) {
+		return errIndex
+	}
+	if l.bug != "SetIgnored" {
+		l.elems[index] = t
+	}
+	return nil
+}
+
+func (l *sliceList) Remove(index int) (int, error) {
+	if index < 0 || index >= len(l.elems) {
+		if l.bug == "RemoveNoError" {
+			return 0, nil
+		}
+		return 0, errIndex
+	}
+	t := l.elems[index]
+	l.elems = slices.Delete(l.elems, index, index+1)
+	return t, nil
+}
+
+func (l *sliceList) Len() int {
+	return len(l.elems)
+}
+
+func (l *sliceList) Cap() int {
+	return cap(l.elems)
+}
+
+func (l *sliceList) Range(fn func(index int, t int) error) error {
+	for i, t := range l.elems {
+		if err := fn(i, t); err != nil && l.bug != "RangeIgnoresError" {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *sliceList) AsSlice() []int {
+	if l.bug == "AsSliceShared" {
+		return l.elems[:len(l.elems):len(l.elems)]
+	}
+	return append(make([]int, 0, len(l.elems)), l.elems...)
+}
+
+func newConfig(bug string) Config[int] {
+	return Config[int]{
+		New: func() list.List[int] { return &sliceList{elems: []int{}, bug: bug} },
+		Gen: func(r *rand.Rand) int { return r.Intn(100) },
+		// 固定种子，保证每个缺陷都能稳定复现
+		Seed:   1,
+		Rounds: 10,
+	}
+}
+
+func TestRun(t *testing.T) {
+	Run(t, newConfig(""))
+}
+
+// bugEnv 指定子进程中 TestRun_Buggy 使用的缺陷
+const bugEnv = "LISTTEST_BUG"
+
+// TestRun_Buggy 只在 TestRun_DetectsBugs 启动的子进程中运行，预期会失败
+func TestRun_Buggy(t *testing.T) {
+	bug := os.Getenv(bugEnv)
+	if bug == "" {
+		t.Skip("只在子进程中运行")
+	}
+	Run(t, newConfig(bug))
+}
+
+// 测试套件能够发现有缺陷的实现：在子进程中运行，避免失败影响当前测试
+func TestRun_DetectsBugs(t *testing.T) {
+	if os.Getenv(bugEnv) != "" {
+		t.Skip("子进程中不再递归")
+	}
+	bugs := []string{
+		"AppendDropsLast",
+		"AddOffByOne",
+		"SetIgnored",
+		"RemoveNoError",
+		"RangeIgnoresError",
+		"AsSliceShared",
+	}
+	for _, bug := range bugs {
+		t.Run(bug, func(t *testing.T) {
+			cmd := exec.Command(os.Args[0], "-test.run=^TestRun_Buggy$", "-test.count=1")
+			cmd.Env = append(os.Environ(), bugEnv+"="+bug)
+			out, err := cmd.CombinedOutput()
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) {
+				t.Fatalf("有缺陷的实现 %s 应该导致测试失败，err = %v\n%s", bug, err, out)
+			}
+			if !strings.Contains(string(out), "--- FAIL: TestRun_Buggy") {
+				t.Fatalf("没有找到 TestRun_Buggy 的失败信息\n%s", out)
+			}
+		})
+	}
+}
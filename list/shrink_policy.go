@@ -0,0 +1,32 @@
+package list
+
+import "mkit/internal/slice"
+
+// ShrinkPolicy 缩容策略，决定 ArrayList 在删除元素之后是否需要缩容
+type ShrinkPolicy interface {
+	// NewCapacity 根据当前的容量和长度计算缩容后的容量
+	// 返回 false 表示不需要缩容，新容量小于长度时同样不会缩容
+	NewCapacity(capacity, length int) (int, bool)
+}
+
+// ShrinkPolicyFunc 将普通函数适配为 ShrinkPolicy
+type ShrinkPolicyFunc func(capacity, length int) (int, bool)
+
+func (f ShrinkPolicyFunc) NewCapacity(capacity, length int) (int, bool) {
+	return f(capacity, length)
+}
+
+// DefaultShrinkPolicy 默认缩容策略
+// - 如果容量 > 2048，并且长度小于容量一半，那么就会缩容为原本的 5/8
+// - 如果容量 (64, 2048]，如果长度是容量的 1/4，那么就会缩容为原本的一半
+// - 如果此时容量 <= 64，那么我们将不会执行缩容
+func DefaultShrinkPolicy() ShrinkPolicy {
+	return ShrinkPolicyFunc(slice.CalCapacity)
+}
+
+// NeverShrinkPolicy 从不缩容，适合对延迟敏感、希望删除时不发生内存分配的场景
+func NeverShrinkPolicy() ShrinkPolicy {
+	return ShrinkPolicyFunc(func(capacity, length int) (int, bool) {
+		return capacity, false
+	})
+}
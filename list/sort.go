@@ -0,0 +1,74 @@
+package list
+
+import (
+	"errors"
+	"slices"
+)
+
+// errStopRange 用于在 Range 的回调中提前结束遍历，不会返回给调用者
+var errStopRange = errors.New("mkit: 停止遍历")
+
+// IsSorted 判断 l 是否已经按照 cmp 升序排列
+// cmp(a, b) 在 a < b 时返回负数，a == b 时返回 0，a > b 时返回正数
+// 通过 Range 顺序遍历一次，对任意 List 都是 O(n)
+func IsSorted[T any](l ReadOnlyList[T], cmp func(a, b T) int) bool {
+	if al, ok := l.(*ArrayList[T]); ok {
+		return slices.IsSortedFunc(al.elems, cmp)
+	}
+
+	sorted := true
+	var prev T
+	_ = l.Range(func(index int, t T) error {
+		if index > 0 && cmp(prev, t) > 0 {
+			sorted = false
+			return errStopRange
+		}
+		prev = t
+		return nil
+	})
+	return sorted
+}
+
+// BinarySearch 在已按 cmp 升序排列的 l 中查找 target
+// 返回 target 所在的下标（或者应当插入的位置），以及是否找到
+// ArrayList、GapBuffer 以及 SubList 视图支持 O(1) 的随机访问，使用二分查找，时间复杂度 O(logn)；
+// SortedList 的 Get 是 O(logn)，同样使用二分查找，时间复杂度 O(log²n)；
+// 其余实现（如 LinkedList、UnrolledLinkedList）的 Get 是 O(n) 的，因此退化为一次顺序遍历，时间复杂度 O(n)
+func BinarySearch[T any](l ReadOnlyList[T], target T, cmp func(a, b T) int) (int, bool) {
+	switch src := l.(type) {
+	case *ArrayList[T]:
+		return slices.BinarySearchFunc(src.elems, target, cmp)
+	case *GapBuffer[T], *subList[T], *SortedList[T]:
+		return bisect(src, target, cmp)
+	}
+
+	pos, found := l.Len(), false
+	_ = l.Range(func(index int, t T) error {
+		if c := cmp(t, target); c >= 0 {
+			pos, found = index, c == 0
+			return errStopRange
+		}
+		return nil
+	})
+	return pos, found
+}
+
+// bisect 通过 Get 进行二分查找，最多调用 log2(n)+1 次 Get，只适用于 Get 不超过 O(logn) 的实现
+func bisect[T any](l ReadOnlyList[T], target T, cmp func(a, b T) int) (int, bool) {
+	// 不变量：[0, lo) 中的元素都小于 target，[hi, n) 中的元素都大于等于 target
+	lo, hi := 0, l.Len()
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		t, _ := l.Get(mid)
+		if cmp(t, target) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == l.Len() {
+		return lo, false
+	}
+	t, _ := l.Get(lo)
+	return lo, cmp(t, target) == 0
+}
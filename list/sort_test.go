@@ -0,0 +1,243 @@
+package list
+
+import (
+	"cmp"
+	"math/bits"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sortable[T any] interface {
+	List[T]
+	Sort(cmp func(a, b T) int)
+	SortStable(cmp func(a, b T) int)
+	Reverse()
+	Swap(i, j int) error
+}
+
+func TestList_Sort(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{name: "空列表", src: []int{}, want: []int{}},
+		{name: "单个元素", src: []int{1}, want: []int{1}},
+		{name: "已经有序", src: []int{1, 2, 3, 4}, want: []int{1, 2, 3, 4}},
+		{name: "逆序", src: []int{5, 4, 3, 2, 1}, want: []int{1, 2, 3, 4, 5}},
+		{name: "包含重复元素", src: []int{3, 1, 2, 3, 1, 0}, want: []int{0, 1, 1, 2, 3, 3}},
+	}
+
+	for _, tc := range testCases {
+		for name, l := range map[string]sortable[int]{
+			"ArrayList":  NewArrayListOf(tc.src),
+			"LinkedList": NewLinkedListOf(tc.src),
+		} {
+			t.Run(name+"-"+tc.name, func(t *testing.T) {
+				l.Sort(cmp.Compare[int])
+				assert.Equal(t, tc.want, l.AsSlice())
+				assert.True(t, IsSorted[int](l, cmp.Compare[int]))
+			})
+		}
+	}
+}
+
+func TestList_SortStable(t *testing.T) {
+	// 只按首字母排序，相等元素应保持原有顺序
+	src := []string{"b1", "a1", "c1", "b2", "a2", "b3"}
+	want := []string{"a1", "a2", "b1", "b2", "b3", "c1"}
+	byFirst := func(a, b string) int {
+		return strings.Compare(a[:1], b[:1])
+	}
+
+	for name, l := range map[string]sortable[string]{
+		"ArrayList":  NewArrayListOf(src),
+		"LinkedList": NewLinkedListOf(src),
+	} {
+		t.Run(name, func(t *testing.T) {
+			l.SortStable(byFirst)
+			assert.Equal(t, want, l.AsSlice())
+		})
+	}
+}
+
+func TestLinkedList_SortKeepsLinks(t *testing.T) {
+	ll := NewLinkedListOf([]int{4, 2, 5, 1, 3})
+	ll.Sort(cmp.Compare[int])
+
+	// 排序后从尾部向前访问，验证 prev 指针也被正确维护
+	for i, want := range []int{1, 2, 3, 4, 5} {
+		val, err := ll.Get(i)
+		assert.NoError(t, err)
+		assert.Equal(t, want, val)
+	}
+	assert.NoError(t, ll.Add(ll.Len(), 6))
+	removed, err := ll.Remove(ll.Len() - 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, removed)
+	assert.Equal(t, []int{1, 2, 3, 4, 6}, ll.AsSlice())
+}
+
+func TestList_Reverse(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want []int
+	}{
+		{name: "空列表", src: []int{}, want: []int{}},
+		{name: "单个元素", src: []int{1}, want: []int{1}},
+		{name: "偶数个元素", src: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+		{name: "奇数个元素", src: []int{1, 2, 3}, want: []int{3, 2, 1}},
+	}
+
+	for _, tc := range testCases {
+		for name, l := range map[string]sortable[int]{
+			"ArrayList":  NewArrayListOf(tc.src),
+			"LinkedList": NewLinkedListOf(tc.src),
+		} {
+			t.Run(name+"-"+tc.name, func(t *testing.T) {
+				l.Reverse()
+				assert.Equal(t, tc.want, l.AsSlice())
+				// 反转之后继续在头尾插入，验证哨兵节点没有被破坏
+				assert.NoError(t, l.Add(0, -1))
+				assert.NoError(t, l.Append(-2))
+				assert.Equal(t, append(append([]int{-1}, tc.want...), -2), l.AsSlice())
+			})
+		}
+	}
+}
+
+func TestList_Swap(t *testing.T) {
+	for name, l := range map[string]sortable[int]{
+		"ArrayList":  NewArrayListOf([]int{1, 2, 3}),
+		"LinkedList": NewLinkedListOf([]int{1, 2, 3}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.NoError(t, l.Swap(0, 2))
+			assert.Equal(t, []int{3, 2, 1}, l.AsSlice())
+			assert.NoError(t, l.Swap(1, 1))
+			assert.Equal(t, []int{3, 2, 1}, l.AsSlice())
+			assert.Error(t, l.Swap(-1, 0))
+			assert.Error(t, l.Swap(0, 3))
+			assert.Equal(t, []int{3, 2, 1}, l.AsSlice())
+		})
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  []int
+		want bool
+	}{
+		{name: "空列表", src: []int{}, want: true},
+		{name: "升序", src: []int{1, 2, 2, 3}, want: true},
+		{name: "无序", src: []int{1, 3, 2}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for name, l := range searchLists(tc.src) {
+				assert.Equal(t, tc.want, IsSorted(l, cmp.Compare[int]), name)
+			}
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	src := []int{1, 3, 3, 5, 7}
+	testCases := []struct {
+		name      string
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{name: "找到首个元素", target: 1, wantIndex: 0, wantFound: true},
+		{name: "重复元素返回第一个", target: 3, wantIndex: 1, wantFound: true},
+		{name: "找到末尾元素", target: 7, wantIndex: 4, wantFound: true},
+		{name: "小于所有元素", target: 0, wantIndex: 0, wantFound: false},
+		{name: "位于中间的缺失元素", target: 4, wantIndex: 3, wantFound: false},
+		{name: "大于所有元素", target: 8, wantIndex: 5, wantFound: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for name, l := range searchLists(src) {
+				index, found := BinarySearch(l, tc.target, cmp.Compare[int])
+				assert.Equal(t, tc.wantIndex, index, name)
+				assert.Equal(t, tc.wantFound, found, name)
+			}
+		})
+	}
+}
+
+// searchLists 返回包含 src 中元素的各种 List 实现
+func searchLists(src []int) map[string]List[int] {
+	gap := NewGapBufferOf(src)
+	// 光标位于中间，覆盖间隙两侧的下标
+	_ = gap.MoveCursor(len(src) / 2)
+	return map[string]List[int]{
+		"ArrayList":          NewArrayListOf(src),
+		"LinkedList":         NewLinkedListOf(src),
+		"UnrolledLinkedList": NewUnrolledLinkedListOf(src),
+		"GapBuffer":          gap,
+		"SubList":            subListOf(src),
+	}
+}
+
+func subListOf(src []int) List[int] {
+	l := NewArrayListOf(append(append([]int{-100}, src...), 100))
+	sub, _ := l.SubList(1, len(src)+1)
+	return sub
+}
+
+// getCounter 记录 Get 的调用次数
+type getCounter struct {
+	List[int]
+	gets int
+}
+
+func (g *getCounter) Get(index int) (int, error) {
+	g.gets++
+	return g.List.Get(index)
+}
+
+// 各种实现的查找结果都与 slices.BinarySearch 一致；
+// 支持随机访问的实现使用二分查找，Get 的调用次数是 O(log n)
+func TestBinarySearch_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 10, 1000} {
+		src := make([]int, n)
+		for i := range src {
+			src[i] = r.Intn(n + 1)
+		}
+		slices.Sort(src)
+		for name, l := range searchLists(src) {
+			for target := -1; target <= n+1; target += max(1, n/50) {
+				wantIndex, wantFound := slices.BinarySearch(src, target)
+				index, found := BinarySearch(l, target, cmp.Compare[int])
+				require.Equal(t, wantIndex, index, "%s n = %d target = %d", name, n, target)
+				require.Equal(t, wantFound, found, "%s n = %d target = %d", name, n, target)
+				// 封装之后不再是本包中的实现，退化为顺序遍历，结果同样一致
+				index, found = BinarySearch[int](&getCounter{List: l}, target, cmp.Compare[int])
+				require.Equal(t, wantIndex, index, "%s n = %d target = %d", name, n, target)
+				require.Equal(t, wantFound, found, "%s n = %d target = %d", name, n, target)
+
+				if name != "GapBuffer" && name != "SubList" {
+					continue
+				}
+				counter := &getCounter{List: l}
+				index, found = bisect[int](counter, target, cmp.Compare[int])
+				require.Equal(t, wantIndex, index, "%s n = %d target = %d", name, n, target)
+				require.Equal(t, wantFound, found, "%s n = %d target = %d", name, n, target)
+				// 二分查找最多调用 log2(n)+1 次 Get
+				require.LessOrEqual(t, counter.gets, bits.Len(uint(n))+1, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package list
+
+import "mkit/internal/errs"
+
+var _ ReadOnlyList[int] = (*SortedList[int])(nil)
+
+// sortedNode 是 SortedList 中 AVL 树的节点，size 为以该节点为根的子树中的元素个数
+type sortedNode[T any] struct {
+	val    T
+	left   *sortedNode[T]
+	right  *sortedNode[T]
+	height int
+	size   int
+}
+
+func (n *sortedNode[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *sortedNode[T]) getSize() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *sortedNode[T]) update() {
+	n.height = max(n.left.getHeight(), n.right.getHeight()) + 1
+	n.size = n.left.getSize() + n.right.getSize() + 1
+}
+
+// SortedList 有序列表（有序多重集合）
+// 元素按照 cmp 从小到大排列，允许存在相等的元素，相等的元素按照插入的先后顺序排列。
+// 底层是记录子树大小的 AVL 树，插入、删除、按值查找和按排名查找都是 O(log n)
+type SortedList[T any] struct {
+	root *sortedNode[T]
+	cmp  func(a, b T) int
+}
+
+// NewSortedList 创建一个按照 cmp 排序的有序列表
+// cmp 的返回值小于 0 表示 a 排在 b 之前，等于 0 表示两者相等
+func NewSortedList[T any](cmp func(a, b T) int) *SortedList[T] {
+	return &SortedList[T]{cmp: cmp}
+}
+
+// NewSortedListOf 使用 ts 中的元素创建有序列表，不会修改 ts
+func NewSortedListOf[T any](ts []T, cmp func(a, b T) int) *SortedList[T] {
+	l := NewSortedList[T](cmp)
+	for _, t := range ts {
+		l.Insert(t)
+	}
+	return l
+}
+
+// Len 返回长度
+func (l *SortedList[T]) Len() int {
+	return l.root.getSize()
+}
+
+// Insert 插入元素，相等的元素会排在已有元素之后
+func (l *SortedList[T]) Insert(t T) {
+	l.root = l.insert(l.root, t)
+}
+
+func (l *SortedList[T]) insert(n *sortedNode[T], t T) *sortedNode[T] {
+	if n == nil {
+		return &sortedNode[T]{val: t, height: 1, size: 1}
+	}
+	if l.cmp(t, n.val) < 0 {
+		n.left = l.insert(n.left, t)
+	} else {
+		n.right = l.insert(n.right, t)
+	}
+	return rebalance(n)
+}
+
+// Remove 删除第一个与 t 相等的元素，返回是否删除成功
+func (l *SortedList[T]) Remove(t T) bool {
+	rank := l.Rank(t)
+	if rank >= l.Len() {
+		return false
+	}
+	if v, _ := l.At(rank); l.cmp(v, t) != 0 {
+		return false
+	}
+	l.root, _ = removeAt(l.root, rank)
+	return true
+}
+
+// RemoveAt 删除排名为 rank 的元素，并且返回该元素
+// 如果 rank 超出范围，应该返回错误
+func (l *SortedList[T]) RemoveAt(rank int) (T, error) {
+	if rank < 0 || rank >= l.Len() {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(l.Len(), rank)
+	}
+	var t T
+	l.root, t = removeAt(l.root, rank)
+	return t, nil
+}
+
+// removeAt 删除子树 n 中下标为 index 的元素，返回新的根节点和被删除的元素
+func removeAt[T any](n *sortedNode[T], index int) (*sortedNode[T], T) {
+	var t T
+	switch leftSize := n.left.getSize(); {
+	case index < leftSize:
+		n.left, t = removeAt(n.left, index)
+	case index > leftSize:
+		n.right, t = removeAt(n.right, index-leftSize-1)
+	default:
+		t = n.val
+		if n.left == nil {
+			return n.right, t
+		}
+		if n.right == nil {
+			return n.left, t
+		}
+		// 用后继节点（右子树中最小的元素）替换当前节点
+		n.right, n.val = removeAt(n.right, 0)
+	}
+	return rebalance(n), t
+}
+
+// Rank 返回小于 t 的元素个数
+// 如果存在与 t 相等的元素，那么它就是第一个相等元素的排名；否则是 t 应该插入的位置
+func (l *SortedList[T]) Rank(t T) int {
+	rank := 0
+	for n := l.root; n != nil; {
+		if l.cmp(n.val, t) < 0 {
+			rank += n.left.getSize() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// Count 返回与 t 相等的元素个数
+func (l *SortedList[T]) Count(t T) int {
+	upper := 0
+	for n := l.root; n != nil; {
+		if l.cmp(n.val, t) <= 0 {
+			upper += n.left.getSize() + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return upper - l.Rank(t)
+}
+
+// Contains 判断是否存在与 t 相等的元素
+func (l *SortedList[T]) Contains(t T) bool {
+	_, ok := l.find(t, func(c int) bool { return c == 0 }, false)
+	return ok
+}
+
+// At 返回排名为 rank 的元素，即从小到大的第 rank 个元素（从 0 开始）
+// 如果 rank 超出范围，应该返回错误
+func (l *SortedList[T]) At(rank int) (T, error) {
+	if rank < 0 || rank >= l.Len() {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(l.Len(), rank)
+	}
+	n := l.root
+	for {
+		leftSize := n.left.getSize()
+		switch {
+		case rank < leftSize:
+			n = n.left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			n = n.right
+		default:
+			return n.val, nil
+		}
+	}
+}
+
+// Get 与 At 相同，用于与 List 保持一致的按下标访问
+func (l *SortedList[T]) Get(index int) (T, error) {
+	return l.At(index)
+}
+
+// Floor 返回小于等于 t 的最大元素，不存在时返回 false
+func (l *SortedList[T]) Floor(t T) (T, bool) {
+	return l.find(t, func(c int) bool { return c <= 0 }, true)
+}
+
+// Ceiling 返回大于等于 t 的最小元素，不存在时返回 false
+func (l *SortedList[T]) Ceiling(t T) (T, bool) {
+	return l.find(t, func(c int) bool { return c >= 0 }, false)
+}
+
+// find 查找满足 match(cmp(val, t)) 的元素，
+// greatest 为 true 时返回其中最大的元素，否则返回最小的元素
+// match 需要满足单调性：对于 Floor 是一段前缀，对于 Ceiling 是一段后缀
+func (l *SortedList[T]) find(t T, match func(c int) bool, greatest bool) (T, bool) {
+	var (
+		res   T
+		found bool
+	)
+	for n := l.root; n != nil; {
+		c := l.cmp(n.val, t)
+		if match(c) {
+			res, found = n.val, true
+			if greatest {
+				n = n.right
+			} else {
+				n = n.left
+			}
+			continue
+		}
+		if c < 0 {
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return res, found
+}
+
+// Between 按照从小到大的顺序遍历 [lo, hi] 范围内的元素，index 为元素的排名
+// fn 返回错误时停止遍历并返回该错误
+func (l *SortedList[T]) Between(lo, hi T, fn func(index int, t T) error) error {
+	return l.rangeNode(l.root, 0, lo, hi, fn)
+}
+
+func (l *SortedList[T]) rangeNode(n *sortedNode[T], offset int, lo, hi T, fn func(index int, t T) error) error {
+	if n == nil {
+		return nil
+	}
+	index := offset + n.left.getSize()
+	geLo, leHi := l.cmp(n.val, lo) >= 0, l.cmp(n.val, hi) <= 0
+	if geLo {
+		if err := l.rangeNode(n.left, offset, lo, hi, fn); err != nil {
+			return err
+		}
+	}
+	if geLo && leHi {
+		if err := fn(index, n.val); err != nil {
+			return err
+		}
+	}
+	if leHi {
+		return l.rangeNode(n.right, index+1, lo, hi, fn)
+	}
+	return nil
+}
+
+// Range 按照从小到大的顺序遍历所有元素，index 为元素的排名
+// fn 返回错误时停止遍历并返回该错误
+func (l *SortedList[T]) Range(fn func(index int, t T) error) error {
+	return eachNode(l.root, 0, fn)
+}
+
+func eachNode[T any](n *sortedNode[T], offset int, fn func(index int, t T) error) error {
+	if n == nil {
+		return nil
+	}
+	index := offset + n.left.getSize()
+	if err := eachNode(n.left, offset, fn); err != nil {
+		return err
+	}
+	if err := fn(index, n.val); err != nil {
+		return err
+	}
+	return eachNode(n.right, index+1, fn)
+}
+
+// AsSlice 按照从小到大的顺序返回所有元素
+// 在没有元素的情况下，返回一个长度和容量都为 0 的切片
+func (l *SortedList[T]) AsSlice() []T {
+	res := make([]T, 0, l.Len())
+	_ = l.Range(func(index int, t T) error {
+		res = append(res, t)
+		return nil
+	})
+	return res
+}
+
+// rebalance 更新 n 的高度和大小，并通过旋转恢复平衡
+func rebalance[T any](n *sortedNode[T]) *sortedNode[T] {
+	n.update()
+	switch balance := n.left.getHeight() - n.right.getHeight(); {
+	case balance > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func rotateLeft[T any](n *sortedNode[T]) *sortedNode[T] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update()
+	r.update()
+	return r
+}
+
+func rotateRight[T any](n *sortedNode[T]) *sortedNode[T] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update()
+	l.update()
+	return l
+}
@@ -0,0 +1,203 @@
+package list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedList_Basic(t *testing.T) {
+	l := NewSortedListOf([]int{5, 1, 3, 3, 9}, cmp.Compare[int])
+	assert.Equal(t, 5, l.Len())
+	assert.Equal(t, []int{1, 3, 3, 5, 9}, l.AsSlice())
+
+	testCases := []struct {
+		name      string
+		t         int
+		rank      int
+		count     int
+		floor     int
+		floorOK   bool
+		ceiling   int
+		ceilingOK bool
+	}{
+		{name: "小于最小值", t: 0, rank: 0, ceiling: 1, ceilingOK: true},
+		{name: "等于最小值", t: 1, rank: 0, count: 1, floor: 1, floorOK: true, ceiling: 1, ceilingOK: true},
+		{name: "重复元素", t: 3, rank: 1, count: 2, floor: 3, floorOK: true, ceiling: 3, ceilingOK: true},
+		{name: "不存在的中间值", t: 4, rank: 3, floor: 3, floorOK: true, ceiling: 5, ceilingOK: true},
+		{name: "大于最大值", t: 10, rank: 5, floor: 9, floorOK: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.rank, l.Rank(tc.t))
+			assert.Equal(t, tc.count, l.Count(tc.t))
+			assert.Equal(t, tc.count > 0, l.Contains(tc.t))
+			floor, ok := l.Floor(tc.t)
+			assert.Equal(t, tc.floorOK, ok)
+			assert.Equal(t, tc.floor, floor)
+			ceiling, ok := l.Ceiling(tc.t)
+			assert.Equal(t, tc.ceilingOK, ok)
+			assert.Equal(t, tc.ceiling, ceiling)
+		})
+	}
+
+	_, err := l.At(5)
+	assert.Error(t, err)
+	_, err = l.Get(-1)
+	assert.Error(t, err)
+	_, err = l.RemoveAt(5)
+	assert.Error(t, err)
+
+	assert.False(t, l.Remove(4))
+	assert.True(t, l.Remove(3))
+	assert.Equal(t, []int{1, 3, 5, 9}, l.AsSlice())
+	v, err := l.RemoveAt(0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []int{3, 5, 9}, l.AsSlice())
+}
+
+func TestSortedList_Between(t *testing.T) {
+	l := NewSortedListOf([]int{1, 2, 2, 4, 6, 8}, cmp.Compare[int])
+	testCases := []struct {
+		name        string
+		lo, hi      int
+		wantIndexes []int
+		wantValues  []int
+	}{
+		{name: "全部", lo: 0, hi: 10, wantIndexes: []int{0, 1, 2, 3, 4, 5}, wantValues: []int{1, 2, 2, 4, 6, 8}},
+		{name: "闭区间", lo: 2, hi: 6, wantIndexes: []int{1, 2, 3, 4}, wantValues: []int{2, 2, 4, 6}},
+		{name: "边界不存在", lo: 3, hi: 7, wantIndexes: []int{3, 4}, wantValues: []int{4, 6}},
+		{name: "空区间", lo: 5, hi: 5},
+		{name: "lo 大于 hi", lo: 6, hi: 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var indexes, values []int
+			err := l.Between(tc.lo, tc.hi, func(index int, t int) error {
+				indexes = append(indexes, index)
+				values = append(values, t)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIndexes, indexes)
+			assert.Equal(t, tc.wantValues, values)
+		})
+	}
+
+	visited := 0
+	err := l.Between(0, 10, func(index int, t int) error {
+		visited++
+		if index == 2 {
+			return errStopRange
+		}
+		return nil
+	})
+	assert.Equal(t, errStopRange, err)
+	assert.Equal(t, 3, visited)
+}
+
+func TestSortedList_Stable(t *testing.T) {
+	type player struct {
+		name  string
+		score int
+	}
+	byScore := func(a, b player) int {
+		return cmp.Compare(b.score, a.score)
+	}
+	l := NewSortedList(byScore)
+	l.Insert(player{name: "a", score: 10})
+	l.Insert(player{name: "b", score: 20})
+	l.Insert(player{name: "c", score: 10})
+	l.Insert(player{name: "d", score: 30})
+	// 分数相同的元素按照插入的先后顺序排列
+	assert.Equal(t, []player{{"d", 30}, {"b", 20}, {"a", 10}, {"c", 10}}, l.AsSlice())
+	assert.Equal(t, 2, l.Rank(player{score: 10}))
+}
+
+func TestSortedList_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	l := NewSortedList(cmp.Compare[int])
+	var model []int
+	for step := 0; step < 5000; step++ {
+		v := r.Intn(200)
+		switch op := r.Intn(5); {
+		case op < 3:
+			l.Insert(v)
+			index, _ := slices.BinarySearch(model, v+1)
+			model = slices.Insert(model, index, v)
+		case op == 3:
+			index, found := slices.BinarySearch(model, v)
+			require.Equal(t, found, l.Remove(v))
+			if found {
+				model = slices.Delete(model, index, index+1)
+			}
+		case len(model) > 0:
+			index := r.Intn(len(model))
+			got, err := l.RemoveAt(index)
+			require.NoError(t, err)
+			require.Equal(t, model[index], got)
+			model = slices.Delete(model, index, index+1)
+		}
+
+		require.Equal(t, len(model), l.Len())
+		rank, _ := slices.BinarySearch(model, v)
+		require.Equal(t, rank, l.Rank(v))
+		if len(model) > 0 {
+			index := r.Intn(len(model))
+			got, err := l.At(index)
+			require.NoError(t, err)
+			require.Equal(t, model[index], got)
+		}
+		if step%100 == 0 {
+			checkAVL(t, l.root)
+		}
+	}
+	checkAVL(t, l.root)
+	require.Equal(t, model, l.AsSlice())
+}
+
+// checkAVL 检查 AVL 树的平衡性以及记录的高度和大小
+func checkAVL[T any](t *testing.T, n *sortedNode[T]) (height, size int) {
+	if n == nil {
+		return 0, 0
+	}
+	lh, ls := checkAVL(t, n.left)
+	rh, rs := checkAVL(t, n.right)
+	require.LessOrEqual(t, max(lh-rh, rh-lh), 1)
+	require.Equal(t, max(lh, rh)+1, n.height)
+	require.Equal(t, ls+rs+1, n.size)
+	return n.height, n.size
+}
+
+// SortedList 实现了 ReadOnlyList，可以直接使用只读取元素的函数
+func TestSortedList_ReadOnlyList(t *testing.T) {
+	l := NewSortedListOf([]int{5, 1, 3, 3, 9}, cmp.Compare[int])
+	var indexes, values []int
+	err := l.Range(func(index int, t int) error {
+		indexes = append(indexes, index)
+		values = append(values, t)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, indexes)
+	assert.Equal(t, []int{1, 3, 3, 5, 9}, values)
+
+	eq := func(a, b int) bool { return a == b }
+	assert.True(t, Equal[int](l, NewArrayListOf([]int{1, 3, 3, 5, 9}), eq))
+	assert.True(t, IsSorted[int](l, cmp.Compare[int]))
+	for target, want := range map[int]int{0: 0, 3: 1, 4: 3, 9: 4, 10: 5} {
+		index, found := BinarySearch[int](l, target, cmp.Compare[int])
+		assert.Equal(t, want, index, "target = %d", target)
+		assert.Equal(t, l.Contains(target), found, "target = %d", target)
+	}
+	assert.Empty(t, Diff[int](l, NewArrayListOf([]int{1, 3, 3, 5, 9}), eq))
+
+	clone := Clone[int](l)
+	require.NoError(t, clone.Append(0))
+	assert.Equal(t, []int{1, 3, 3, 5, 9}, l.AsSlice())
+}
@@ -0,0 +1,164 @@
+package list
+
+import "mkit/internal/errs"
+
+var (
+	_ List[any] = &subList[any]{}
+)
+
+// subList 是 ArrayList 中 [offset, offset+size) 范围的视图
+// 视图本身不存储元素，所有读写都直接作用在 root 上
+type subList[T any] struct {
+	root *ArrayList[T]
+	// parent 是创建该视图的上一级视图，直接由 ArrayList 创建时为 nil
+	// 通过视图做结构性修改时，需要同步更新所有上级视图的长度
+	parent   *subList[T]
+	offset   int
+	size     int
+	modCount int
+}
+
+// checkRange 检查 [from, to) 是否是长度为 length 的列表中的合法范围
+func checkRange(length, from, to int) error {
+	if from < 0 || from > length {
+		return errs.NewErrIndexOutOfRange(length, from)
+	}
+	if to < from || to > length {
+		return errs.NewErrIndexOutOfRange(length, to)
+	}
+	return nil
+}
+
+func (s *subList[T]) checkModification() error {
+	if s.modCount != s.root.modCount {
+		return ErrConcurrentModification
+	}
+	return nil
+}
+
+// updateSize 在通过视图做了结构性修改之后，同步自身及上级视图的长度和修改次数
+func (s *subList[T]) updateSize(delta int) {
+	for cur := s; cur != nil; cur = cur.parent {
+		cur.size += delta
+		cur.modCount = s.root.modCount
+	}
+}
+
+func (s *subList[T]) Get(index int) (T, error) {
+	if err := s.checkModification(); err != nil {
+		var zeroValue T
+		return zeroValue, err
+	}
+	if index < 0 || index >= s.size {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(s.size, index)
+	}
+	return s.root.elems[s.offset+index], nil
+}
+
+func (s *subList[T]) Append(ts ...T) error {
+	return s.AddAll(s.size, ts...)
+}
+
+func (s *subList[T]) Add(index int, t T) error {
+	return s.AddAll(index, t)
+}
+
+// AddAll 在视图的 index 处依次插入 ts，ArrayList 中位于视图之后的元素整体后移
+func (s *subList[T]) AddAll(index int, ts ...T) error {
+	if err := s.checkModification(); err != nil {
+		return err
+	}
+	if index < 0 || index > s.size {
+		return errs.NewErrIndexOutOfRange(s.size, index)
+	}
+	// 没有插入元素时不是结构性修改，不能让其他视图失效
+	if len(ts) == 0 {
+		return nil
+	}
+	if err := s.root.AddAll(s.offset+index, ts...); err != nil {
+		return err
+	}
+	s.updateSize(len(ts))
+	return nil
+}
+
+func (s *subList[T]) Set(index int, t T) error {
+	if err := s.checkModification(); err != nil {
+		return err
+	}
+	if index < 0 || index >= s.size {
+		return errs.NewErrIndexOutOfRange(s.size, index)
+	}
+	s.root.elems[s.offset+index] = t
+	return nil
+}
+
+func (s *subList[T]) Remove(index int) (T, error) {
+	if err := s.checkModification(); err != nil {
+		var zeroValue T
+		return zeroValue, err
+	}
+	if index < 0 || index >= s.size {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(s.size, index)
+	}
+	t, err := s.root.Remove(s.offset + index)
+	if err != nil {
+		return t, err
+	}
+	s.updateSize(-1)
+	return t, nil
+}
+
+// Len 返回视图的长度，视图失效之后返回 0
+func (s *subList[T]) Len() int {
+	if s.checkModification() != nil {
+		return 0
+	}
+	return s.size
+}
+
+// Cap 视图不能扩容，容量与长度相同
+func (s *subList[T]) Cap() int {
+	return s.Len()
+}
+
+func (s *subList[T]) Range(fn func(index int, t T) error) error {
+	if err := s.checkModification(); err != nil {
+		return err
+	}
+	for i, v := range s.root.elems[s.offset : s.offset+s.size] {
+		if err := fn(i, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AsSlice 返回视图中元素的拷贝，视图失效之后返回空切片
+func (s *subList[T]) AsSlice() []T {
+	if s.checkModification() != nil || s.size == 0 {
+		return make([]T, 0)
+	}
+	result := make([]T, s.size)
+	copy(result, s.root.elems[s.offset:s.offset+s.size])
+	return result
+}
+
+// SubList 返回视图中 [from, to) 范围的子视图
+func (s *subList[T]) SubList(from, to int) (List[T], error) {
+	if err := s.checkModification(); err != nil {
+		return nil, err
+	}
+	if err := checkRange(s.size, from, to); err != nil {
+		return nil, err
+	}
+	return &subList[T]{
+		root:     s.root,
+		parent:   s,
+		offset:   s.offset + from,
+		size:     to - from,
+		modCount: s.modCount,
+	}, nil
+}
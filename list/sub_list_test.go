@@ -0,0 +1,190 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrayList_SubList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int
+		want     []int
+		wantErr  bool
+	}{
+		{name: "中间范围", from: 1, to: 4, want: []int{2, 3, 4}},
+		{name: "全部范围", from: 0, to: 5, want: []int{1, 2, 3, 4, 5}},
+		{name: "空范围", from: 5, to: 5, want: []int{}},
+		{name: "from越界", from: -1, to: 2, wantErr: true},
+		{name: "to越界", from: 1, to: 6, wantErr: true},
+		{name: "from大于to", from: 3, to: 1, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := NewArrayListOf([]int{1, 2, 3, 4, 5})
+			sub, err := l.SubList(tc.from, tc.to)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, sub.AsSlice())
+			assert.Equal(t, len(tc.want), sub.Len())
+		})
+	}
+}
+
+func TestSubList_WriteThrough(t *testing.T) {
+	l := NewArrayListOf([]int{1, 2, 3, 4, 5})
+	sub, err := l.SubList(1, 4)
+	require.NoError(t, err)
+
+	// 非结构性修改双向可见
+	require.NoError(t, sub.Set(0, 20))
+	assert.Equal(t, []int{1, 20, 3, 4, 5}, l.AsSlice())
+	require.NoError(t, l.Set(3, 40))
+	val, err := sub.Get(2)
+	require.NoError(t, err)
+	assert.Equal(t, 40, val)
+
+	// 通过视图做结构性修改，视图依然有效
+	require.NoError(t, sub.Add(1, 99))
+	assert.Equal(t, []int{1, 20, 99, 3, 40, 5}, l.AsSlice())
+	assert.Equal(t, []int{20, 99, 3, 40}, sub.AsSlice())
+
+	require.NoError(t, sub.Append(100))
+	assert.Equal(t, []int{1, 20, 99, 3, 40, 100, 5}, l.AsSlice())
+
+	removed, err := sub.Remove(0)
+	require.NoError(t, err)
+	assert.Equal(t, 20, removed)
+	assert.Equal(t, []int{99, 3, 40, 100}, sub.AsSlice())
+	assert.Equal(t, []int{1, 99, 3, 40, 100, 5}, l.AsSlice())
+
+	var got []int
+	require.NoError(t, sub.Range(func(index int, t int) error {
+		got = append(got, t)
+		return nil
+	}))
+	assert.Equal(t, []int{99, 3, 40, 100}, got)
+
+	_, err = sub.Get(4)
+	assert.Error(t, err)
+	assert.Error(t, sub.Set(-1, 0))
+}
+
+func TestSubList_Nested(t *testing.T) {
+	l := NewArrayListOf([]int{0, 1, 2, 3, 4, 5, 6, 7})
+	outer, err := l.SubList(2, 7)
+	require.NoError(t, err)
+	inner, err := outer.(*subList[int]).SubList(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, inner.AsSlice())
+
+	// 通过内层视图修改之后，外层视图的长度也要同步更新
+	require.NoError(t, inner.Append(9))
+	assert.Equal(t, []int{3, 4, 9}, inner.AsSlice())
+	assert.Equal(t, []int{2, 3, 4, 9, 5, 6}, outer.AsSlice())
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 9, 5, 6, 7}, l.AsSlice())
+
+	// 通过外层视图修改之后，内层视图失效
+	require.NoError(t, outer.Add(0, -1))
+	_, err = inner.Get(0)
+	assert.True(t, errors.Is(err, ErrConcurrentModification))
+}
+
+func TestSubList_ConcurrentModification(t *testing.T) {
+	modifications := map[string]func(l *ArrayList[int]) error{
+		"Append": func(l *ArrayList[int]) error { return l.Append(6) },
+		"Add":    func(l *ArrayList[int]) error { return l.Add(0, 6) },
+		"Remove": func(l *ArrayList[int]) error {
+			_, err := l.Remove(0)
+			return err
+		},
+		"AddAll": func(l *ArrayList[int]) error { return l.AddAll(1, 6, 7) },
+		"RemoveIf": func(l *ArrayList[int]) error {
+			l.RemoveIf(func(t int) bool { return t > 4 })
+			return nil
+		},
+		"RemoveRange": func(l *ArrayList[int]) error { return l.RemoveRange(0, 2) },
+		"Clear": func(l *ArrayList[int]) error {
+			l.Clear()
+			return nil
+		},
+	}
+
+	for name, modify := range modifications {
+		t.Run(name, func(t *testing.T) {
+			l := NewArrayListOf([]int{1, 2, 3, 4, 5})
+			sub, err := l.SubList(1, 3)
+			require.NoError(t, err)
+			require.NoError(t, modify(l))
+
+			_, err = sub.Get(0)
+			assert.ErrorIs(t, err, ErrConcurrentModification)
+			assert.ErrorIs(t, sub.Set(0, 1), ErrConcurrentModification)
+			assert.ErrorIs(t, sub.Append(1), ErrConcurrentModification)
+			assert.ErrorIs(t, sub.Add(0, 1), ErrConcurrentModification)
+			_, err = sub.Remove(0)
+			assert.ErrorIs(t, err, ErrConcurrentModification)
+			assert.ErrorIs(t, sub.Range(func(index int, t int) error { return nil }), ErrConcurrentModification)
+			assert.Equal(t, 0, sub.Len())
+			assert.Equal(t, []int{}, sub.AsSlice())
+		})
+	}
+}
+
+// 插入空的 ts 不是结构性修改，其他视图仍然有效
+func TestSubList_EmptyAddAll(t *testing.T) {
+	l := NewArrayListOf([]int{1, 2, 3, 4, 5})
+	view, err := l.SubList(0, 2)
+	require.NoError(t, err)
+	sub := view.(*subList[int])
+	sibling, err := l.SubList(2, 5)
+	require.NoError(t, err)
+
+	require.NoError(t, sub.AddAll(1))
+	require.NoError(t, sub.Append())
+	require.NoError(t, l.AddAll(0))
+	require.NoError(t, l.Append())
+
+	assert.Equal(t, []int{1, 2}, sub.AsSlice())
+	assert.Equal(t, []int{3, 4, 5}, sibling.AsSlice())
+	require.NoError(t, sibling.Set(0, 30))
+	assert.Equal(t, []int{1, 2, 30, 4, 5}, l.AsSlice())
+
+	// 下标不合法时仍然返回错误
+	assert.Error(t, sub.AddAll(3))
+}
+
+// 清空一个空列表不是结构性修改，不会使视图失效
+func TestSubList_ClearEmpty(t *testing.T) {
+	l := NewArrayList[int]()
+	sub, err := l.SubList(0, 0)
+	require.NoError(t, err)
+	l.Clear()
+	assert.Equal(t, 0, sub.Len())
+	require.NoError(t, sub.Append(1))
+	assert.Equal(t, []int{1}, l.AsSlice())
+
+	// 非空时仍然会使视图失效
+	l.Clear()
+	_, err = sub.Get(0)
+	assert.ErrorIs(t, err, ErrConcurrentModification)
+}
+
+func TestSubList_NonStructuralModification(t *testing.T) {
+	l := NewArrayListOf([]int{3, 1, 2})
+	sub, err := l.SubList(0, 2)
+	require.NoError(t, err)
+
+	// 不改变长度的修改不会使视图失效
+	require.NoError(t, l.Set(0, 5))
+	require.NoError(t, l.Swap(0, 2))
+	l.Reverse()
+	assert.Equal(t, []int{5, 1}, sub.AsSlice())
+}
@@ -0,0 +1,243 @@
+package list
+
+import (
+	"slices"
+
+	"mkit/internal/errs"
+)
+
+var (
+	_ List[any] = &UnrolledLinkedList[any]{}
+)
+
+// defaultUnrolledNodeSize 每个节点最多存储的元素个数
+const defaultUnrolledNodeSize = 64
+
+// unrolledNode 是展开链表的节点，每个节点在一段连续内存中存储多个元素
+type unrolledNode[T any] struct {
+	elems []T // 长度不超过 nodeSize，容量固定为 nodeSize
+	prev  *unrolledNode[T]
+	next  *unrolledNode[T]
+}
+
+// UnrolledLinkedList 展开链表（Unrolled Linked List）
+// 每个节点存储一小段连续的元素，遍历时可以充分利用 CPU 缓存，
+// 速度接近 ArrayList；而在中间插入、删除时只需要移动一个节点内的元素，
+// 代价远小于 ArrayList 移动后续的全部元素
+type UnrolledLinkedList[T any] struct {
+	head     *unrolledNode[T]
+	tail     *unrolledNode[T]
+	length   int
+	nodes    int
+	nodeSize int
+}
+
+// NewUnrolledLinkedList 创建一个空的展开链表
+func NewUnrolledLinkedList[T any]() *UnrolledLinkedList[T] {
+	return newUnrolledLinkedList[T](defaultUnrolledNodeSize)
+}
+
+// NewUnrolledLinkedListOf 使用 ts 中的元素创建展开链表，会复制 ts
+func NewUnrolledLinkedListOf[T any](ts []T) *UnrolledLinkedList[T] {
+	l := NewUnrolledLinkedList[T]()
+	_ = l.Append(ts...)
+	return l
+}
+
+func newUnrolledLinkedList[T any](nodeSize int) *UnrolledLinkedList[T] {
+	return &UnrolledLinkedList[T]{nodeSize: nodeSize}
+}
+
+func (l *UnrolledLinkedList[T]) newNode() *unrolledNode[T] {
+	if l.nodeSize <= 0 {
+		// 兼容零值 UnrolledLinkedList
+		l.nodeSize = defaultUnrolledNodeSize
+	}
+	l.nodes++
+	return &unrolledNode[T]{elems: make([]T, 0, l.nodeSize)}
+}
+
+// insertAfter 将 n 插入到 prev 之后，prev 为 nil 表示插入到头部
+func (l *UnrolledLinkedList[T]) insertAfter(prev, n *unrolledNode[T]) {
+	n.prev = prev
+	if prev == nil {
+		n.next, l.head = l.head, n
+	} else {
+		n.next, prev.next = prev.next, n
+	}
+	if n.next == nil {
+		l.tail = n
+	} else {
+		n.next.prev = n
+	}
+}
+
+func (l *UnrolledLinkedList[T]) unlink(n *unrolledNode[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev, n.next = nil, nil
+	l.nodes--
+}
+
+// findNode 返回 index 所在的节点，以及 index 在节点内的偏移量
+// 根据 index 离头尾的远近决定从哪一端开始查找，调用者需要保证 index 合法
+func (l *UnrolledLinkedList[T]) findNode(index int) (*unrolledNode[T], int) {
+	if index < l.length/2 {
+		cur := l.head
+		for index >= len(cur.elems) {
+			index -= len(cur.elems)
+			cur = cur.next
+		}
+		return cur, index
+	}
+	cur, rest := l.tail, l.length-index
+	for rest > len(cur.elems) {
+		rest -= len(cur.elems)
+		cur = cur.prev
+	}
+	return cur, len(cur.elems) - rest
+}
+
+func (l *UnrolledLinkedList[T]) checkIndex(index int) bool {
+	return 0 <= index && index < l.length
+}
+
+// Get 返回对应下标的元素，在下标超出范围的情况下，返回错误
+func (l *UnrolledLinkedList[T]) Get(index int) (T, error) {
+	if !l.checkIndex(index) {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(l.length, index)
+	}
+	n, offset := l.findNode(index)
+	return n.elems[offset], nil
+}
+
+// Append 在末尾追加元素，先填满尾节点，再按需创建新节点
+func (l *UnrolledLinkedList[T]) Append(ts ...T) error {
+	for len(ts) > 0 {
+		if l.tail == nil || len(l.tail.elems) == l.nodeSize {
+			l.insertAfter(l.tail, l.newNode())
+		}
+		n := min(len(ts), l.nodeSize-len(l.tail.elems))
+		l.tail.elems = append(l.tail.elems, ts[:n]...)
+		l.length += n
+		ts = ts[n:]
+	}
+	return nil
+}
+
+// Add 在特定下标处增加一个新元素
+// 如果下标不在[0, Len()]范围之内
+// 应该返回错误
+// 如果index == Len()则表示往List末端增加一个值
+func (l *UnrolledLinkedList[T]) Add(index int, t T) error {
+	if index < 0 || index > l.length {
+		return errs.NewErrIndexOutOfRange(l.length, index)
+	}
+	if index == l.length {
+		return l.Append(t)
+	}
+
+	n, offset := l.findNode(index)
+	if len(n.elems) == l.nodeSize {
+		// 节点已满，把后一半元素拆分到新节点中
+		half := l.nodeSize / 2
+		next := l.newNode()
+		next.elems = append(next.elems, n.elems[half:]...)
+		clear(n.elems[half:])
+		n.elems = n.elems[:half]
+		l.insertAfter(n, next)
+		if offset > half {
+			n, offset = next, offset-half
+		}
+	}
+	n.elems = slices.Insert(n.elems, offset, t)
+	l.length++
+	return nil
+}
+
+// Set 重置 index 位置的值
+// 如果下标超出范围，应该返回错误
+func (l *UnrolledLinkedList[T]) Set(index int, t T) error {
+	if !l.checkIndex(index) {
+		return errs.NewErrIndexOutOfRange(l.length, index)
+	}
+	n, offset := l.findNode(index)
+	n.elems[offset] = t
+	return nil
+}
+
+// Remove 删除目标元素的位置，并且返回该位置的值
+// 节点中的元素少于一半时，会与后继节点合并或者从后继节点借用元素，
+// 保证节点的平均利用率
+func (l *UnrolledLinkedList[T]) Remove(index int) (T, error) {
+	if !l.checkIndex(index) {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(l.length, index)
+	}
+
+	n, offset := l.findNode(index)
+	t := n.elems[offset]
+	n.elems = slices.Delete(n.elems, offset, offset+1)
+	l.length--
+
+	switch {
+	case len(n.elems) == 0:
+		l.unlink(n)
+	case len(n.elems) < l.nodeSize/2 && n.next != nil:
+		next := n.next
+		if len(n.elems)+len(next.elems) <= l.nodeSize {
+			n.elems = append(n.elems, next.elems...)
+			l.unlink(next)
+		} else {
+			moved := (len(next.elems) - len(n.elems)) / 2
+			n.elems = append(n.elems, next.elems[:moved]...)
+			next.elems = slices.Delete(next.elems, 0, moved)
+		}
+	}
+	return t, nil
+}
+
+// Len 返回长度
+func (l *UnrolledLinkedList[T]) Len() int {
+	return l.length
+}
+
+// Cap 返回所有节点的容量之和
+func (l *UnrolledLinkedList[T]) Cap() int {
+	return l.nodes * l.nodeSize
+}
+
+// Range 遍历 List 的所有元素
+func (l *UnrolledLinkedList[T]) Range(fn func(index int, t T) error) error {
+	i := 0
+	for cur := l.head; cur != nil; cur = cur.next {
+		for _, v := range cur.elems {
+			if err := fn(i, v); err != nil {
+				return err
+			}
+			i++
+		}
+	}
+	return nil
+}
+
+// AsSlice 将 List 转化为一个切片
+// 不允许返回nil，在没有元素的情况下，
+// 必须返回一个长度和容量都为 0 的切片
+// AsSlice 每次调用都必须返回一个全新的切片
+func (l *UnrolledLinkedList[T]) AsSlice() []T {
+	res := make([]T, 0, l.length)
+	for cur := l.head; cur != nil; cur = cur.next {
+		res = append(res, cur.elems...)
+	}
+	return res
+}
@@ -0,0 +1,174 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnrolledLinkedList_Basic(t *testing.T) {
+	l := newUnrolledLinkedList[int](4)
+	assert.Equal(t, 0, l.Len())
+	assert.Equal(t, 0, l.Cap())
+	assert.Equal(t, []int{}, l.AsSlice())
+
+	require.NoError(t, l.Append(1, 2, 3, 4, 5, 6))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, l.AsSlice())
+	assert.Equal(t, 2, l.nodes)
+	assert.Equal(t, 8, l.Cap())
+
+	// 在已满的节点中间插入，会拆分节点
+	require.NoError(t, l.Add(1, 10))
+	assert.Equal(t, []int{1, 10, 2, 3, 4, 5, 6}, l.AsSlice())
+	assert.Equal(t, 3, l.nodes)
+
+	require.NoError(t, l.Add(0, 20))
+	require.NoError(t, l.Add(l.Len(), 30))
+	assert.Equal(t, []int{20, 1, 10, 2, 3, 4, 5, 6, 30}, l.AsSlice())
+
+	for i, want := range []int{20, 1, 10, 2, 3, 4, 5, 6, 30} {
+		val, err := l.Get(i)
+		require.NoError(t, err)
+		assert.Equal(t, want, val)
+	}
+
+	require.NoError(t, l.Set(8, 31))
+	val, err := l.Get(8)
+	require.NoError(t, err)
+	assert.Equal(t, 31, val)
+
+	_, err = l.Get(9)
+	assert.Error(t, err)
+	assert.Error(t, l.Set(-1, 0))
+	assert.Error(t, l.Add(10, 0))
+	_, err = l.Remove(9)
+	assert.Error(t, err)
+}
+
+func TestUnrolledLinkedList_Remove(t *testing.T) {
+	l := newUnrolledLinkedList[int](4)
+	require.NoError(t, l.Append(1, 2, 3, 4, 5, 6, 7, 8, 9))
+	assert.Equal(t, 3, l.nodes)
+
+	// 第一个节点少于一半后，与第二个节点不能合并，只能借用元素
+	for _, want := range []int{1, 2, 3} {
+		val, err := l.Remove(0)
+		require.NoError(t, err)
+		assert.Equal(t, want, val)
+	}
+	assert.Equal(t, []int{4, 5, 6, 7, 8, 9}, l.AsSlice())
+
+	// 删除到只剩一个节点
+	for l.Len() > 1 {
+		_, err := l.Remove(l.Len() / 2)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, l.nodes)
+	_, err := l.Remove(0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, l.nodes)
+	assert.Equal(t, []int{}, l.AsSlice())
+
+	// 删除全部元素之后可以继续使用
+	require.NoError(t, l.Add(0, 1))
+	assert.Equal(t, []int{1}, l.AsSlice())
+}
+
+func TestUnrolledLinkedList_ZeroValue(t *testing.T) {
+	var l UnrolledLinkedList[string]
+	require.NoError(t, l.Append("a", "b"))
+	require.NoError(t, l.Add(1, "c"))
+	assert.Equal(t, []string{"a", "c", "b"}, l.AsSlice())
+	assert.Equal(t, defaultUnrolledNodeSize, l.Cap())
+}
+
+func TestNewUnrolledLinkedListOf(t *testing.T) {
+	src := make([]int, 200)
+	for i := range src {
+		src[i] = i
+	}
+	l := NewUnrolledLinkedListOf(src)
+	assert.Equal(t, src, l.AsSlice())
+	assert.Equal(t, 4, l.nodes)
+	var got []int
+	require.NoError(t, l.Range(func(index int, t int) error {
+		got = append(got, t)
+		return nil
+	}))
+	assert.Equal(t, src, got)
+}
+
+// 以下 benchmark 对比各个 List 实现，使用 go test -bench=BenchmarkList -benchmem 运行
+
+const benchmarkListSize = 100_000
+
+func benchmarkLists() map[string]func() List[int] {
+	return map[string]func() List[int]{
+		"ArrayList":          func() List[int] { return NewArrayList[int]() },
+		"LinkedList":         func() List[int] { return NewLinkedList[int]() },
+		"UnrolledLinkedList": func() List[int] { return NewUnrolledLinkedList[int]() },
+		"GapBuffer":          func() List[int] { return NewGapBuffer[int](0) },
+	}
+}
+
+func newBenchmarkList(newList func() List[int], size int) List[int] {
+	l := newList()
+	for i := 0; i < size; i++ {
+		_ = l.Append(i)
+	}
+	return l
+}
+
+func BenchmarkList_Range(b *testing.B) {
+	for name, newList := range benchmarkLists() {
+		b.Run(name, func(b *testing.B) {
+			l := newBenchmarkList(newList, benchmarkListSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				sum := 0
+				_ = l.Range(func(index int, t int) error {
+					sum += t
+					return nil
+				})
+			}
+		})
+	}
+}
+
+func BenchmarkList_Append(b *testing.B) {
+	for name, newList := range benchmarkLists() {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				newBenchmarkList(newList, 10_000)
+			}
+		})
+	}
+}
+
+func BenchmarkList_AddMiddle(b *testing.B) {
+	for name, newList := range benchmarkLists() {
+		b.Run(name, func(b *testing.B) {
+			l := newBenchmarkList(newList, 10_000)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = l.Add(l.Len()/2, i)
+				_, _ = l.Remove(l.Len() / 2)
+			}
+		})
+	}
+}
+
+func BenchmarkList_Get(b *testing.B) {
+	for name, newList := range benchmarkLists() {
+		b.Run(name, func(b *testing.B) {
+			l := newBenchmarkList(newList, 10_000)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = l.Get(i % 10_000)
+			}
+		})
+	}
+}
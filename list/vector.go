@@ -0,0 +1,378 @@
+package list
+
+import (
+	"slices"
+
+	"mkit/internal/errs"
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// vectorNode 是 Vector 中 32 叉前缀树的节点
+// 内部节点只使用 children，叶子节点只使用 elems，节点一旦创建就不会再被修改
+// sizes 为 nil 的节点是严格节点：除了最后一个子树之外，每个子树都是满的，可以直接按位定位；
+// Remove 会让路径上的节点变为宽松节点（RRB-Tree 中的 relaxed node），
+// 此时 sizes[i] 记录前 i+1 个子树中的元素总数，通过它定位子树
+type vectorNode[T any] struct {
+	children []*vectorNode[T]
+	elems    []T
+	sizes    []int
+}
+
+func (n *vectorNode[T]) clone() *vectorNode[T] {
+	return &vectorNode[T]{children: slices.Clone(n.children), elems: n.elems, sizes: n.sizes}
+}
+
+// size 返回位于 level 层的节点 n 中的元素个数
+func (n *vectorNode[T]) size(level uint) int {
+	switch {
+	case level == 0:
+		return len(n.elems)
+	case n.sizes != nil:
+		return n.sizes[len(n.sizes)-1]
+	case len(n.children) == 0:
+		return 0
+	}
+	last := len(n.children) - 1
+	return last<<level + n.children[last].size(level-vectorBits)
+}
+
+// child 返回位于 level 层的节点 n 中包含相对下标 index 的子树，以及 index 在该子树中的相对下标
+func (n *vectorNode[T]) child(level uint, index int) (int, int) {
+	sub := index >> level
+	if n.sizes == nil {
+		return sub, index - sub<<level
+	}
+	// 每个子树最多有 1<<level 个元素，因此目标子树不会在 sub 之前
+	for n.sizes[sub] <= index {
+		sub++
+	}
+	if sub == 0 {
+		return 0, index
+	}
+	return sub, index - n.sizes[sub-1]
+}
+
+// relaxedSizes 返回位于 level 层的节点 n 的 sizes，严格节点会先计算出来，返回的切片可以直接修改
+func (n *vectorNode[T]) relaxedSizes(level uint) []int {
+	if n.sizes != nil {
+		return slices.Clone(n.sizes)
+	}
+	sizes := make([]int, len(n.children))
+	total := 0
+	for i, c := range n.children {
+		total += c.size(level - vectorBits)
+		sizes[i] = total
+	}
+	return sizes
+}
+
+// Vector 不可变的持久化向量
+// 元素存储在一棵 32 叉前缀树中，末尾的最多 32 个元素单独存放在 tail 中。
+// Append、Set、Remove 都不会修改原来的 Vector，而是返回一个新的版本，
+// 新版本只复制从根节点到被修改叶子节点的路径，其余节点与旧版本共享，均为 O(log n)。
+// 因为任何版本都不会被修改，所以可以在多个 goroutine 之间无锁地共享
+// 零值 Vector 可以直接使用，表示一个空的 Vector
+type Vector[T any] struct {
+	root   *vectorNode[T]
+	tail   []T
+	length int
+	// shift 为根节点所在层的位移量，叶子节点的位移量为 0
+	shift uint
+}
+
+// NewVector 创建一个空的 Vector
+func NewVector[T any]() *Vector[T] {
+	return &Vector[T]{}
+}
+
+// NewVectorOf 使用 ts 中的元素创建 Vector，会复制 ts
+func NewVectorOf[T any](ts []T) *Vector[T] {
+	return NewVector[T]().Append(ts...)
+}
+
+// NewVectorFromList 使用 List 中的元素创建 Vector
+func NewVectorFromList[T any](l List[T]) *Vector[T] {
+	return NewVectorOf(l.AsSlice())
+}
+
+// ToList 将 Vector 转化为一个 ArrayList，之后对 ArrayList 的修改不会影响 Vector
+func (v *Vector[T]) ToList() *ArrayList[T] {
+	// AsSlice 每次都返回全新的切片，可以直接作为底层存储
+	return &ArrayList[T]{elems: v.AsSlice()}
+}
+
+// Len 返回长度
+func (v *Vector[T]) Len() int {
+	return v.length
+}
+
+func (v *Vector[T]) checkIndex(index int) bool {
+	return 0 <= index && index < v.length
+}
+
+// tailOffset 返回 tail 中第一个元素的下标
+func (v *Vector[T]) tailOffset() int {
+	return v.length - len(v.tail)
+}
+
+// leafFor 返回 index 所在的叶子节点中的元素，以及 index 在其中的下标，调用者需要保证 index 合法
+func (v *Vector[T]) leafFor(index int) ([]T, int) {
+	if offset := v.tailOffset(); index >= offset {
+		return v.tail, index - offset
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		var sub int
+		sub, index = n.child(level, index)
+		n = n.children[sub]
+	}
+	return n.elems, index
+}
+
+// Get 返回对应下标的元素，在下标超出范围的情况下，返回错误
+func (v *Vector[T]) Get(index int) (T, error) {
+	if !v.checkIndex(index) {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(v.length, index)
+	}
+	leaf, i := v.leafFor(index)
+	return leaf[i], nil
+}
+
+// Append 返回在末尾追加 ts 之后的新版本
+func (v *Vector[T]) Append(ts ...T) *Vector[T] {
+	if len(ts) == 0 {
+		return v
+	}
+	res := *v
+	// tail 可能与其他版本共享，先复制一份再追加
+	tail := make([]T, len(v.tail), vectorWidth)
+	copy(tail, v.tail)
+	for _, t := range ts {
+		if len(tail) == vectorWidth {
+			res.pushTail(tail)
+			tail = make([]T, 0, vectorWidth)
+		}
+		tail = append(tail, t)
+		res.length++
+	}
+	res.tail = tail
+	return &res
+}
+
+// pushTail 将已满的 tail 作为叶子节点放入树中，只会修改 v 自身的字段
+func (v *Vector[T]) pushTail(tail []T) {
+	leaf := &vectorNode[T]{elems: tail}
+	if v.root == nil {
+		v.root, v.shift = &vectorNode[T]{}, vectorBits
+	}
+	if root, ok := pushVectorLeaf(v.root, v.shift, leaf); ok {
+		v.root = root
+		return
+	}
+	// 树已满，增加一层
+	root := &vectorNode[T]{children: []*vectorNode[T]{v.root, newVectorPath(v.shift, leaf)}}
+	if v.root.sizes != nil {
+		size := v.root.size(v.shift)
+		root.sizes = []int{size, size + len(tail)}
+	}
+	v.root = root
+	v.shift += vectorBits
+}
+
+// pushVectorLeaf 复制 n 最右侧的路径，并在最右侧放入 leaf，n 中没有空位时返回 false
+func pushVectorLeaf[T any](n *vectorNode[T], level uint, leaf *vectorNode[T]) (*vectorNode[T], bool) {
+	last := len(n.children) - 1
+	if level > vectorBits && last >= 0 {
+		if child, ok := pushVectorLeaf(n.children[last], level-vectorBits, leaf); ok {
+			res := n.clone()
+			res.children[last] = child
+			if n.sizes != nil {
+				res.sizes = slices.Clone(n.sizes)
+				res.sizes[last] += len(leaf.elems)
+			}
+			return res, true
+		}
+	}
+	if len(n.children) == vectorWidth {
+		return nil, false
+	}
+	res := n.clone()
+	res.children = append(res.children, newVectorPath(level-vectorBits, leaf))
+	if n.sizes != nil {
+		res.sizes = append(slices.Clone(n.sizes), n.size(level)+len(leaf.elems))
+	}
+	return res, true
+}
+
+// newVectorPath 创建一条从 level 层到 leaf 的路径
+func newVectorPath[T any](level uint, leaf *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newVectorPath(level-vectorBits, leaf)}}
+}
+
+// Set 返回将 index 位置的值替换为 t 之后的新版本
+// 如果下标超出范围，返回原来的 Vector 和错误
+func (v *Vector[T]) Set(index int, t T) (*Vector[T], error) {
+	if !v.checkIndex(index) {
+		return v, errs.NewErrIndexOutOfRange(v.length, index)
+	}
+	res := *v
+	if offset := v.tailOffset(); index >= offset {
+		res.tail = slices.Clone(v.tail)
+		res.tail[index-offset] = t
+	} else {
+		res.root = setVectorLeaf(v.root, v.shift, index, t)
+	}
+	return &res, nil
+}
+
+func setVectorLeaf[T any](n *vectorNode[T], level uint, index int, t T) *vectorNode[T] {
+	res := n.clone()
+	if level == 0 {
+		res.elems = slices.Clone(n.elems)
+		res.elems[index] = t
+		return res
+	}
+	sub, index := n.child(level, index)
+	res.children[sub] = setVectorLeaf(n.children[sub], level-vectorBits, index, t)
+	return res
+}
+
+// Remove 返回删除 index 位置的元素之后的新版本，以及被删除的元素
+// 只复制从根节点到 index 所在叶子节点的路径，路径上的节点会变为宽松节点，其余节点与旧版本共享
+// 如果下标超出范围，返回原来的 Vector 和错误
+func (v *Vector[T]) Remove(index int) (*Vector[T], T, error) {
+	if !v.checkIndex(index) {
+		var zeroValue T
+		return v, zeroValue, errs.NewErrIndexOutOfRange(v.length, index)
+	}
+	leaf, i := v.leafFor(index)
+	t := leaf[i]
+	if v.length == 1 {
+		return NewVector[T](), t, nil
+	}
+	res := *v
+	res.length--
+	if index >= v.tailOffset() {
+		res.tail = slices.Delete(slices.Clone(v.tail), i, i+1)
+		if len(res.tail) == 0 {
+			// tail 不能为空，把树中最后一个叶子节点作为新的 tail
+			res.popLeaf()
+		}
+		return &res, t, nil
+	}
+	res.root = removeVectorElem(v.root, v.shift, index)
+	res.normalize()
+	return &res, t, nil
+}
+
+// removeVectorElem 复制从 n 到相对下标 index 所在叶子节点的路径，并删除该元素
+// 返回 nil 表示删除之后 n 为空
+func removeVectorElem[T any](n *vectorNode[T], level uint, index int) *vectorNode[T] {
+	if level == 0 {
+		if len(n.elems) == 1 {
+			return nil
+		}
+		return &vectorNode[T]{elems: slices.Delete(slices.Clone(n.elems), index, index+1)}
+	}
+	sub, childIndex := n.child(level, index)
+	child := removeVectorElem(n.children[sub], level-vectorBits, childIndex)
+	if child == nil && len(n.children) == 1 {
+		return nil
+	}
+	res := n.clone()
+	res.sizes = n.relaxedSizes(level)
+	for i := sub; i < len(res.sizes); i++ {
+		res.sizes[i]--
+	}
+	if child == nil {
+		res.children = slices.Delete(res.children, sub, sub+1)
+		res.sizes = slices.Delete(res.sizes, sub, sub+1)
+	} else {
+		res.children[sub] = child
+	}
+	return res
+}
+
+// popLeaf 将树中最后一个叶子节点移出，作为新的 tail，只会修改 v 自身的字段
+func (v *Vector[T]) popLeaf() {
+	var leaf *vectorNode[T]
+	v.root, leaf = popVectorLeaf(v.root, v.shift)
+	v.tail = leaf.elems
+	v.normalize()
+}
+
+// popVectorLeaf 复制 n 最右侧的路径并删除最后一个叶子节点
+// 返回 nil 表示删除之后 n 为空
+func popVectorLeaf[T any](n *vectorNode[T], level uint) (*vectorNode[T], *vectorNode[T]) {
+	last := len(n.children) - 1
+	child, leaf := n.children[last], n.children[last]
+	if level > vectorBits {
+		child, leaf = popVectorLeaf(child, level-vectorBits)
+	} else {
+		child = nil
+	}
+	if child == nil && last == 0 {
+		return nil, leaf
+	}
+	res := n.clone()
+	if child == nil {
+		res.children = res.children[:last]
+	} else {
+		res.children[last] = child
+	}
+	if n.sizes != nil {
+		res.sizes = slices.Clone(n.sizes[:len(res.children)])
+		if child != nil {
+			res.sizes[last] -= len(leaf.elems)
+		}
+	}
+	return res, leaf
+}
+
+// normalize 在树为空时清空根节点，并去掉只有一个子节点的根节点，只会修改 v 自身的字段
+func (v *Vector[T]) normalize() {
+	if v.root == nil {
+		v.shift = 0
+		return
+	}
+	for v.shift > vectorBits && len(v.root.children) == 1 {
+		v.root = v.root.children[0]
+		v.shift -= vectorBits
+	}
+}
+
+// Range 遍历 Vector 的所有元素
+func (v *Vector[T]) Range(fn func(index int, t T) error) error {
+	for i := 0; i < v.length; {
+		leaf, offset := v.leafFor(i)
+		for _, t := range leaf[offset:] {
+			if err := fn(i, t); err != nil {
+				return err
+			}
+			i++
+		}
+	}
+	return nil
+}
+
+// AsSlice 将 Vector 转化为一个切片
+// 在没有元素的情况下，返回一个长度和容量都为 0 的切片
+// AsSlice 每次调用都会返回一个全新的切片
+func (v *Vector[T]) AsSlice() []T {
+	res := make([]T, 0, v.length)
+	for i := 0; i < v.length; {
+		leaf, offset := v.leafFor(i)
+		res = append(res, leaf[offset:]...)
+		i += len(leaf) - offset
+	}
+	return res
+}
@@ -0,0 +1,346 @@
+package list
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVector_Basic(t *testing.T) {
+	var zero Vector[int]
+	assert.Equal(t, 0, zero.Len())
+	assert.Equal(t, []int{}, zero.AsSlice())
+	_, err := zero.Get(0)
+	assert.Error(t, err)
+
+	v := zero.Append(1, 2, 3)
+	assert.Equal(t, []int{1, 2, 3}, v.AsSlice())
+	assert.Equal(t, 0, zero.Len())
+
+	v2, err := v.Set(1, 20)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 20, 3}, v2.AsSlice())
+	assert.Equal(t, []int{1, 2, 3}, v.AsSlice())
+
+	v3, val, err := v2.Remove(0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, val)
+	assert.Equal(t, []int{20, 3}, v3.AsSlice())
+	assert.Equal(t, []int{1, 20, 3}, v2.AsSlice())
+
+	testCases := []struct {
+		name  string
+		index int
+	}{
+		{name: "负数下标", index: -1},
+		{name: "下标等于长度", index: 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := v.Get(tc.index)
+			assert.Error(t, err)
+			res, err := v.Set(tc.index, 0)
+			assert.Error(t, err)
+			assert.Same(t, v, res)
+			res, _, err = v.Remove(tc.index)
+			assert.Error(t, err)
+			assert.Same(t, v, res)
+		})
+	}
+}
+
+func TestVector_Persistent(t *testing.T) {
+	// 跨越 tail 以及树增加一层、两层的边界
+	for _, n := range []int{0, 1, 31, 32, 33, 1024, 1056, 1057, 2000, 32800, 32801} {
+		want := make([]int, n)
+		for i := range want {
+			want[i] = i
+		}
+		v := NewVectorOf(want)
+		require.Equal(t, want, v.AsSlice(), "n = %d", n)
+
+		appended := v.Append(-1)
+		assert.Equal(t, append(slices.Clone(want), -1), appended.AsSlice())
+		if n == 0 {
+			continue
+		}
+
+		for _, index := range []int{0, n / 2, n - 1} {
+			set, err := v.Set(index, -1)
+			require.NoError(t, err)
+			expected := slices.Clone(want)
+			expected[index] = -1
+			assert.Equal(t, expected, set.AsSlice())
+
+			removed, val, err := v.Remove(index)
+			require.NoError(t, err)
+			assert.Equal(t, index, val)
+			assert.Equal(t, slices.Delete(slices.Clone(want), index, index+1), removed.AsSlice())
+		}
+		// 旧版本始终保持不变
+		assert.Equal(t, want, v.AsSlice())
+	}
+}
+
+func TestVector_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	// 同时保留所有历史版本，并验证每个版本都与模型一致
+	versions := []*Vector[int]{NewVector[int]()}
+	models := [][]int{{}}
+	for step := 0; step < 3000; step++ {
+		i := r.Intn(len(versions))
+		v, model := versions[i], models[i]
+		switch op := r.Intn(10); {
+		case op < 5:
+			ts := make([]int, r.Intn(70))
+			for j := range ts {
+				ts[j] = r.Int()
+			}
+			v = v.Append(ts...)
+			model = append(slices.Clone(model), ts...)
+		case op < 7 && len(model) > 0:
+			index, val := r.Intn(len(model)), r.Int()
+			var err error
+			v, err = v.Set(index, val)
+			require.NoError(t, err)
+			model = slices.Clone(model)
+			model[index] = val
+		case len(model) > 0:
+			// 偏向于删除末尾的元素，覆盖 tail 与树之间的转换
+			index := len(model) - 1 - r.Intn(min(len(model), 40))
+			var (
+				val int
+				err error
+			)
+			v, val, err = v.Remove(index)
+			require.NoError(t, err)
+			require.Equal(t, model[index], val)
+			model = slices.Delete(slices.Clone(model), index, index+1)
+		}
+		versions = append(versions, v)
+		models = append(models, model)
+	}
+	for i, v := range versions {
+		require.Equal(t, len(models[i]), v.Len())
+		require.Equal(t, models[i], v.AsSlice())
+	}
+}
+
+// 在任意位置删除，并与追加、修改交替进行，覆盖宽松节点的各种情况
+func TestVector_RandomRemove(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	model := make([]int, 5000)
+	for i := range model {
+		model[i] = i
+	}
+	v := NewVectorOf(model)
+	old, oldModel := v, slices.Clone(model)
+	for step := 0; step < 6000; step++ {
+		switch op := r.Intn(10); {
+		case op < 2:
+			ts := make([]int, r.Intn(70))
+			for j := range ts {
+				ts[j] = r.Int()
+			}
+			v = v.Append(ts...)
+			model = append(model, ts...)
+		case op < 3 && len(model) > 0:
+			index, val := r.Intn(len(model)), r.Int()
+			var err error
+			v, err = v.Set(index, val)
+			require.NoError(t, err)
+			model[index] = val
+		case len(model) > 0:
+			index := r.Intn(len(model))
+			var (
+				val int
+				err error
+			)
+			v, val, err = v.Remove(index)
+			require.NoError(t, err)
+			require.Equal(t, model[index], val)
+			model = slices.Delete(model, index, index+1)
+		}
+		if step%200 == 0 {
+			checkVector(t, v)
+			require.Equal(t, model, v.AsSlice())
+			for i := 0; i < len(model); i += 37 {
+				got, err := v.Get(i)
+				require.NoError(t, err)
+				require.Equal(t, model[i], got)
+			}
+		}
+	}
+	checkVector(t, v)
+	assert.Equal(t, model, v.AsSlice())
+	assert.Equal(t, oldModel, old.AsSlice())
+
+	// 删除所有元素
+	for v.Len() > 0 {
+		v, _, _ = v.Remove(r.Intn(v.Len()))
+	}
+	assert.Equal(t, []int{}, v.AsSlice())
+	assert.Equal(t, []int{1, 2}, v.Append(1, 2).AsSlice())
+}
+
+// Remove 只复制一条路径，其余叶子节点与旧版本共享
+func TestVector_RemoveSharing(t *testing.T) {
+	n := 32801
+	ts := make([]int, n)
+	for i := range ts {
+		ts[i] = i
+	}
+	v := NewVectorOf(ts)
+	for _, index := range []int{0, n / 2} {
+		removed, _, err := v.Remove(index)
+		require.NoError(t, err)
+		checkVector(t, removed)
+		leaves := func(v *Vector[int]) map[*int]bool {
+			res := make(map[*int]bool)
+			for i := 0; i < v.tailOffset(); i += vectorWidth {
+				leaf, _ := v.leafFor(i)
+				res[&leaf[0]] = true
+			}
+			return res
+		}
+		oldLeaves, shared := leaves(v), 0
+		for leaf := range leaves(removed) {
+			if oldLeaves[leaf] {
+				shared++
+			}
+		}
+		// 只有被删除元素所在的叶子节点是新的
+		assert.Equal(t, len(oldLeaves)-1, shared, "index = %d", index)
+	}
+}
+
+// checkVector 验证宽松节点的 sizes 与实际的元素个数一致，并且严格节点中除最后一个子树外都是满的
+func checkVector[T any](t *testing.T, v *Vector[T]) {
+	t.Helper()
+	if v.root == nil {
+		require.Equal(t, v.length, len(v.tail))
+		return
+	}
+	require.Equal(t, v.tailOffset(), checkVectorNode(t, v.root, v.shift))
+	if v.length > 0 {
+		require.NotEmpty(t, v.tail)
+	}
+}
+
+func checkVectorNode[T any](t *testing.T, n *vectorNode[T], level uint) int {
+	if level == 0 {
+		require.NotEmpty(t, n.elems)
+		return len(n.elems)
+	}
+	require.NotEmpty(t, n.children)
+	require.LessOrEqual(t, len(n.children), vectorWidth)
+	total := 0
+	for i, c := range n.children {
+		size := checkVectorNode(t, c, level-vectorBits)
+		total += size
+		if n.sizes != nil {
+			require.Equal(t, total, n.sizes[i])
+		} else if i < len(n.children)-1 {
+			require.Nil(t, c.sizes)
+			require.Equal(t, 1<<level, size)
+		}
+	}
+	require.Equal(t, total, n.size(level))
+	return total
+}
+
+func TestVector_Range(t *testing.T) {
+	want := make([]int, 100)
+	for i := range want {
+		want[i] = i * 2
+	}
+	v := NewVectorOf(want)
+	var got []int
+	err := v.Range(func(index int, t int) error {
+		got = append(got, t)
+		if index == 49 {
+			return errStopRange
+		}
+		return nil
+	})
+	assert.Equal(t, errStopRange, err)
+	assert.Equal(t, want[:50], got)
+}
+
+func TestVector_List(t *testing.T) {
+	l := NewArrayListOf([]int{1, 2, 3})
+	v := NewVectorFromList[int](l)
+	assert.Equal(t, []int{1, 2, 3}, v.AsSlice())
+
+	// Vector 与 List 之间相互独立
+	require.NoError(t, l.Set(0, 10))
+	assert.Equal(t, []int{1, 2, 3}, v.AsSlice())
+	res := v.ToList()
+	require.NoError(t, res.Append(4))
+	assert.Equal(t, []int{1, 2, 3, 4}, res.AsSlice())
+	assert.Equal(t, []int{1, 2, 3}, v.AsSlice())
+}
+
+func TestVector_Concurrent(t *testing.T) {
+	v := NewVectorOf(make([]int, 1000))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			cur := v
+			for i := 0; i < 200; i++ {
+				cur, _ = cur.Set(i, g)
+				cur = cur.Append(g)
+			}
+			assert.Equal(t, 1200, cur.Len())
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, make([]int, 1000), v.AsSlice())
+}
+
+func BenchmarkVector_Remove(b *testing.B) {
+	n := 32801
+	ts := make([]int, n)
+	for i := range ts {
+		ts[i] = i
+	}
+	v := NewVectorOf(ts)
+	for _, bm := range []struct {
+		name  string
+		index int
+	}{
+		{name: "Front", index: 0},
+		{name: "Middle", index: n / 2},
+		{name: "Back", index: n - 1},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _, _ = v.Remove(bm.index)
+			}
+		})
+	}
+	b.Run("Set", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = v.Set(n/2, -1)
+		}
+	})
+	// 连续从头部删除，宽松节点上的再次删除同样是 O(log n)
+	b.Run("FrontRepeated", func(b *testing.B) {
+		b.ReportAllocs()
+		cur := v
+		for i := 0; i < b.N; i++ {
+			if cur.Len() == 0 {
+				cur = v
+			}
+			cur, _, _ = cur.Remove(0)
+		}
+	})
+}
@@ -1,8 +1,11 @@
 package queue
 
 import (
+	"sync"
 	"sync/atomic"
 	"unsafe"
+
+	"mkit/internal/epoch"
 )
 
 // node 节点结构体，存储值和下一个节点指针
@@ -15,22 +18,92 @@ type node[T any] struct {
 type ConcurrentLinkedQueue[T any] struct {
 	head unsafe.Pointer // *node[T]
 	tail unsafe.Pointer // *node[T]
+	// recycler 为 nil 表示不复用节点
+	recycler *nodeRecycler[T]
+}
+
+type concurrentLinkedQueueOptions struct {
+	recycleNodes bool
+}
+
+// ConcurrentLinkedQueueOption 创建 ConcurrentLinkedQueue 时的可选配置
+type ConcurrentLinkedQueueOption func(opts *concurrentLinkedQueueOptions)
+
+// WithNodeRecycling 复用出队之后的节点，减少入队时的内存分配
+// 出队的节点要等到所有可能访问它的操作都结束之后才会被复用，因此不会出现 ABA 问题，
+// 代价是每次操作都需要进入和退出纪元
+func WithNodeRecycling() ConcurrentLinkedQueueOption {
+	return func(opts *concurrentLinkedQueueOptions) {
+		opts.recycleNodes = true
+	}
 }
 
 // NewConcurrentLinkedQueue 创建一个新的并发链表队列
-func NewConcurrentLinkedQueue[T any]() *ConcurrentLinkedQueue[T] {
+func NewConcurrentLinkedQueue[T any](opts ...ConcurrentLinkedQueueOption) *ConcurrentLinkedQueue[T] {
+	var o concurrentLinkedQueueOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	head := &node[T]{}
 	ptr := unsafe.Pointer(head)
-	return &ConcurrentLinkedQueue[T]{
+	c := &ConcurrentLinkedQueue[T]{
 		head: ptr,
 		tail: ptr,
 	}
+	if o.recycleNodes {
+		c.recycler = newNodeRecycler[T]()
+	}
+	return c
+}
+
+// nodeRecycler 使用基于纪元的回收机制复用出队的节点
+type nodeRecycler[T any] struct {
+	domain *epoch.Domain[*node[T]]
+	pool   sync.Pool
+}
+
+func newNodeRecycler[T any]() *nodeRecycler[T] {
+	r := &nodeRecycler[T]{}
+	r.pool.New = func() any {
+		return &node[T]{}
+	}
+	r.domain = epoch.NewDomain(func(n *node[T]) {
+		var zero T
+		n.val = zero
+		atomic.StorePointer(&n.next, nil)
+		r.pool.Put(n)
+	})
+	return r
+}
+
+// pin 在开启节点复用时进入纪元，未开启时返回 nil
+func (c *ConcurrentLinkedQueue[T]) pin() *epoch.Guard[*node[T]] {
+	if c.recycler == nil {
+		return nil
+	}
+	return c.recycler.domain.Pin()
+}
+
+func (c *ConcurrentLinkedQueue[T]) unpin(g *epoch.Guard[*node[T]]) {
+	if g != nil {
+		g.Unpin()
+	}
+}
+
+func (c *ConcurrentLinkedQueue[T]) newNode(t T) *node[T] {
+	if c.recycler == nil {
+		return &node[T]{val: t}
+	}
+	n := c.recycler.pool.Get().(*node[T])
+	n.val = t
+	return n
 }
 
 // Enqueue 入队操作（无锁，基于CAS）
 // 参考ByteRhythm-main项目实现
 func (c *ConcurrentLinkedQueue[T]) Enqueue(t T) error {
-	newNode := &node[T]{val: t}
+	defer c.unpin(c.pin())
+	newNode := c.newNode(t)
 	newPtr := unsafe.Pointer(newNode)
 	for {
 		tailPtr := atomic.LoadPointer(&c.tail)
@@ -53,6 +126,8 @@ func (c *ConcurrentLinkedQueue[T]) Enqueue(t T) error {
 
 // Dequeue 出队操作（无锁，基于CAS）
 func (c *ConcurrentLinkedQueue[T]) Dequeue() (T, error) {
+	g := c.pin()
+	defer c.unpin(g)
 	for {
 		headPtr := atomic.LoadPointer(&c.head)
 		head := (*node[T])(headPtr)
@@ -72,6 +147,10 @@ func (c *ConcurrentLinkedQueue[T]) Dequeue() (T, error) {
 		// 尝试推进head指针
 		if atomic.CompareAndSwapPointer(&c.head, headPtr, nextPtr) {
 			next := (*node[T])(nextPtr)
+			if g != nil {
+				// 原来的头节点已经摘下，等到没有其他操作访问它之后再复用
+				g.Retire(head)
+			}
 			return next.val, nil
 		}
 		// 推进失败，继续自旋
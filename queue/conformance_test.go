@@ -0,0 +1,34 @@
+package queue_test
+
+import (
+	"testing"
+
+	"mkit/queue"
+	"mkit/queue/queuetest"
+)
+
+func TestConcurrentLinkedQueue_Conformance(t *testing.T) {
+	queuetest.Run(t, queuetest.Config{
+		New:        func() queue.Queue[int] { return queue.NewConcurrentLinkedQueue[int]() },
+		ErrEmpty:   queue.ErrOutOfCapacity,
+		Concurrent: true,
+	})
+}
+
+func TestConcurrentLinkedQueue_NodeRecycling_Conformance(t *testing.T) {
+	queuetest.Run(t, queuetest.Config{
+		New: func() queue.Queue[int] {
+			return queue.NewConcurrentLinkedQueue[int](queue.WithNodeRecycling())
+		},
+		ErrEmpty:   queue.ErrOutOfCapacity,
+		Concurrent: true,
+	})
+}
+
+func TestRingBuffer_Conformance(t *testing.T) {
+	queuetest.Run(t, queuetest.Config{
+		New:      func() queue.Queue[int] { return queue.NewRingBuffer[int](8) },
+		Capacity: 8,
+		ErrEmpty: queue.ErrEmptyQueue,
+	})
+}
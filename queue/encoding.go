@@ -0,0 +1,97 @@
+package queue
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"sync/atomic"
+	"unsafe"
+)
+
+var (
+	_ json.Marshaler             = &ConcurrentLinkedQueue[any]{}
+	_ json.Unmarshaler           = &ConcurrentLinkedQueue[any]{}
+	_ encoding.BinaryMarshaler   = &ConcurrentLinkedQueue[any]{}
+	_ encoding.BinaryUnmarshaler = &ConcurrentLinkedQueue[any]{}
+	_ gob.GobEncoder             = &ConcurrentLinkedQueue[any]{}
+	_ gob.GobDecoder             = &ConcurrentLinkedQueue[any]{}
+)
+
+// 队列序列化的是某一时刻的快照，按照从队首到队尾的顺序编码为数组：
+// JSON 格式为 JSON 数组，二进制格式为 gob 编码的切片。
+// 反序列化会用快照替换队列中原有的全部元素。
+
+// Snapshot 按照从队首到队尾的顺序返回队列中元素的快照
+// 可以与 Enqueue、Dequeue 并发调用，快照中的元素一定是按顺序入队且在遍历时尚未出队的
+func (c *ConcurrentLinkedQueue[T]) Snapshot() []T {
+	res := make([]T, 0)
+	defer c.unpin(c.pin())
+	headPtr := atomic.LoadPointer(&c.head)
+	if headPtr == nil {
+		return res
+	}
+	for ptr := atomic.LoadPointer(&(*node[T])(headPtr).next); ptr != nil; {
+		n := (*node[T])(ptr)
+		res = append(res, n.val)
+		ptr = atomic.LoadPointer(&n.next)
+	}
+	return res
+}
+
+// restore 用 ts 重建队列，不能与其他操作并发调用
+func (c *ConcurrentLinkedQueue[T]) restore(ts []T) {
+	head := &node[T]{}
+	tail := head
+	for _, t := range ts {
+		n := &node[T]{val: t}
+		tail.next = unsafe.Pointer(n)
+		tail = n
+	}
+	atomic.StorePointer(&c.head, unsafe.Pointer(head))
+	atomic.StorePointer(&c.tail, unsafe.Pointer(tail))
+}
+
+// MarshalJSON 将队列快照序列化为 JSON 数组
+func (c *ConcurrentLinkedQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Snapshot())
+}
+
+// UnmarshalJSON 从 JSON 数组中恢复队列，不能与其他操作并发调用
+func (c *ConcurrentLinkedQueue[T]) UnmarshalJSON(data []byte) error {
+	var ts []T
+	if err := json.Unmarshal(data, &ts); err != nil {
+		return err
+	}
+	c.restore(ts)
+	return nil
+}
+
+// MarshalBinary 使用 gob 将队列快照编码为二进制
+func (c *ConcurrentLinkedQueue[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(c.Snapshot()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary 从 MarshalBinary 的结果中恢复队列，不能与其他操作并发调用
+func (c *ConcurrentLinkedQueue[T]) UnmarshalBinary(data []byte) error {
+	var ts []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&ts); err != nil {
+		return err
+	}
+	c.restore(ts)
+	return nil
+}
+
+// GobEncode 实现 gob.GobEncoder，与 MarshalBinary 相同
+func (c *ConcurrentLinkedQueue[T]) GobEncode() ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+// GobDecode 实现 gob.GobDecoder，与 UnmarshalBinary 相同
+func (c *ConcurrentLinkedQueue[T]) GobDecode(data []byte) error {
+	return c.UnmarshalBinary(data)
+}
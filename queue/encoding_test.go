@@ -0,0 +1,86 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentLinkedQueue_Snapshot(t *testing.T) {
+	q := NewConcurrentLinkedQueue[int]()
+	assert.Equal(t, []int{}, q.Snapshot())
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, q.Enqueue(i))
+	}
+	_, err := q.Dequeue()
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, q.Snapshot())
+
+	// 快照不影响队列本身
+	v, err := q.Dequeue()
+	require.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestConcurrentLinkedQueue_JSON(t *testing.T) {
+	q := NewConcurrentLinkedQueue[[]string]()
+	require.NoError(t, q.Enqueue([]string{"a"}))
+	require.NoError(t, q.Enqueue([]string{"b", "c"}))
+
+	data, err := json.Marshal(q)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[["a"],["b","c"]]`, string(data))
+
+	// 零值队列同样可以反序列化
+	var restored ConcurrentLinkedQueue[[]string]
+	require.NoError(t, json.Unmarshal(data, &restored))
+	assertDrain(t, &restored, [][]string{{"a"}, {"b", "c"}})
+
+	// 反序列化之后的队列可以继续使用
+	require.NoError(t, restored.Enqueue([]string{"d"}))
+	assertDrain(t, &restored, [][]string{{"d"}})
+
+	assert.Error(t, json.Unmarshal([]byte(`{}`), &restored))
+}
+
+func TestConcurrentLinkedQueue_Gob(t *testing.T) {
+	type payload struct {
+		Name  string
+		Queue *ConcurrentLinkedQueue[map[string]int]
+	}
+
+	q := NewConcurrentLinkedQueue[map[string]int]()
+	require.NoError(t, q.Enqueue(map[string]int{"a": 1}))
+	require.NoError(t, q.Enqueue(map[string]int{"b": 2, "c": 3}))
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(payload{Name: "q", Queue: q}))
+	var dst payload
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&dst))
+	assert.Equal(t, "q", dst.Name)
+	assertDrain(t, dst.Queue, []map[string]int{{"a": 1}, {"b": 2, "c": 3}})
+
+	empty, err := NewConcurrentLinkedQueue[int]().MarshalBinary()
+	require.NoError(t, err)
+	restored := NewConcurrentLinkedQueue[int]()
+	require.NoError(t, restored.Enqueue(1))
+	require.NoError(t, restored.UnmarshalBinary(empty))
+	_, err = restored.Dequeue()
+	assert.Error(t, err)
+}
+
+func assertDrain[T any](t *testing.T, q Queue[T], want []T) {
+	t.Helper()
+	for _, w := range want {
+		v, err := q.Dequeue()
+		require.NoError(t, err)
+		assert.Equal(t, w, v)
+	}
+	_, err := q.Dequeue()
+	assert.Error(t, err)
+}
@@ -4,3 +4,6 @@ import "mkit/internal/queue"
 
 // ErrOutOfCapacity 超过容量
 var ErrOutOfCapacity = queue.ErrOutOfCapacity
+
+// ErrEmptyQueue 队列为空
+var ErrEmptyQueue = queue.ErrEmptyQueue
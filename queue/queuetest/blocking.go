@@ -0,0 +1,199 @@
+package queuetest
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"mkit/queue"
+)
+
+// BlockingConfig 描述待测试的 queue.BlockingQueue 实现
+type BlockingConfig struct {
+	// New 创建一个空队列，必须设置
+	New func() queue.BlockingQueue[int]
+	// Capacity 队列的容量，0 表示无界队列
+	Capacity int
+	// Timeout 验证超时语义时使用的等待时间，默认 20ms
+	Timeout time.Duration
+	// Goroutines 线性一致性检查中并发的 goroutine 数量，默认 4
+	Goroutines int
+	// OpsPerGoroutine 线性一致性检查中每个 goroutine 执行的操作数，默认 6
+	// Goroutines * OpsPerGoroutine 不能超过 64
+	OpsPerGoroutine int
+	// Rounds 线性一致性检查的轮数，默认 100
+	Rounds int
+	// Seed 随机数种子，为 0 时使用当前时间
+	Seed int64
+}
+
+func (cfg BlockingConfig) withDefaults(t *testing.T) BlockingConfig {
+	t.Helper()
+	if cfg.New == nil {
+		t.Fatal("queuetest: 必须设置 BlockingConfig.New")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 20 * time.Millisecond
+	}
+	if cfg.Goroutines <= 0 {
+		cfg.Goroutines = 4
+	}
+	if cfg.OpsPerGoroutine <= 0 {
+		cfg.OpsPerGoroutine = 6
+	}
+	if cfg.Goroutines*cfg.OpsPerGoroutine > maxHistory {
+		t.Fatalf("queuetest: Goroutines * OpsPerGoroutine 不能超过 %d", maxHistory)
+	}
+	if cfg.Rounds <= 0 {
+		cfg.Rounds = 100
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	return cfg
+}
+
+// RunBlocking 运行 queue.BlockingQueue 的全部一致性测试
+// 阻塞队列默认是并发安全的，因此总是会进行线性一致性检查
+func RunBlocking(t *testing.T, cfg BlockingConfig) {
+	t.Helper()
+	cfg = cfg.withDefaults(t)
+
+	t.Run("FIFO", func(t *testing.T) {
+		q := cfg.New()
+		n := 100
+		if cfg.Capacity > 0 {
+			n = cfg.Capacity
+		}
+		ctx := context.Background()
+		for i := 0; i < n; i++ {
+			if err := q.Enqueue(ctx, i); err != nil {
+				t.Fatalf("Enqueue(%d) 返回错误: %v", i, err)
+			}
+		}
+		for i := 0; i < n; i++ {
+			v, err := q.Dequeue(ctx)
+			if err != nil || v != i {
+				t.Fatalf("Dequeue() = %d, %v，期望 %d", v, err, i)
+			}
+		}
+	})
+
+	t.Run("DequeueTimeout", func(t *testing.T) {
+		q := cfg.New()
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+		defer cancel()
+		_, err := q.Dequeue(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("队列为空且超时时 Dequeue 应当返回 context.DeadlineExceeded，实际为 %v", err)
+		}
+	})
+
+	t.Run("DequeueCanceled", func(t *testing.T) {
+		q := cfg.New()
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(cfg.Timeout, cancel)
+		_, err := q.Dequeue(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("队列为空且 ctx 被取消时 Dequeue 应当返回 context.Canceled，实际为 %v", err)
+		}
+	})
+
+	t.Run("DequeueWakeUp", func(t *testing.T) {
+		q := cfg.New()
+		time.AfterFunc(cfg.Timeout, func() {
+			_ = q.Enqueue(context.Background(), 42)
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		v, err := q.Dequeue(ctx)
+		if err != nil || v != 42 {
+			t.Fatalf("阻塞的 Dequeue 应当在入队之后被唤醒，实际为 %d, %v", v, err)
+		}
+	})
+
+	if cfg.Capacity > 0 {
+		t.Run("EnqueueTimeout", func(t *testing.T) {
+			q := fill(t, cfg)
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+			defer cancel()
+			if err := q.Enqueue(ctx, -1); !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("队列已满且超时时 Enqueue 应当返回 context.DeadlineExceeded，实际为 %v", err)
+			}
+		})
+
+		t.Run("EnqueueCanceled", func(t *testing.T) {
+			q := fill(t, cfg)
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(cfg.Timeout, cancel)
+			if err := q.Enqueue(ctx, -1); !errors.Is(err, context.Canceled) {
+				t.Fatalf("队列已满且 ctx 被取消时 Enqueue 应当返回 context.Canceled，实际为 %v", err)
+			}
+		})
+
+		t.Run("EnqueueWakeUp", func(t *testing.T) {
+			q := fill(t, cfg)
+			time.AfterFunc(cfg.Timeout, func() {
+				_, _ = q.Dequeue(context.Background())
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := q.Enqueue(ctx, -1); err != nil {
+				t.Fatalf("阻塞的 Enqueue 应当在出队之后被唤醒，实际为 %v", err)
+			}
+		})
+	}
+
+	t.Run("Linearizability", func(t *testing.T) {
+		r := rand.New(rand.NewSource(cfg.Seed))
+		for round := 0; round < cfg.Rounds; round++ {
+			q := cfg.New()
+			// 超时的出队视为观察到队列为空，超时的入队视为观察到队列已满
+			ops := runHistory(cfg.Goroutines, cfg.OpsPerGoroutine, r, func(g int, enqueue bool, v int) (int, bool) {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+				defer cancel()
+				if enqueue {
+					err := q.Enqueue(ctx, v)
+					if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+						t.Errorf("Enqueue 返回了非预期的错误: %v", err)
+					}
+					return v, err == nil
+				}
+				v, err := q.Dequeue(ctx)
+				if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("Dequeue 返回了非预期的错误: %v", err)
+				}
+				return v, err == nil
+			})
+			if !checkLinearizable(ops, cfg.Capacity) {
+				t.Fatalf("第 %d 轮的并发历史不满足线性一致性，Seed = %d\n  %s", round, cfg.Seed, formatHistory(ops))
+			}
+		}
+	})
+
+	t.Run("Stress", func(t *testing.T) {
+		q := cfg.New()
+		stress(t, 8, 8, 1000, func(v int) bool {
+			return q.Enqueue(context.Background(), v) == nil
+		}, func() (int, bool) {
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+			defer cancel()
+			v, err := q.Dequeue(ctx)
+			return v, err == nil
+		})
+	})
+}
+
+// fill 创建一个新的队列并且填满
+func fill(t *testing.T, cfg BlockingConfig) queue.BlockingQueue[int] {
+	t.Helper()
+	q := cfg.New()
+	for i := 0; i < cfg.Capacity; i++ {
+		if err := q.Enqueue(context.Background(), i); err != nil {
+			t.Fatalf("Enqueue(%d) 返回错误: %v", i, err)
+		}
+	}
+	return q
+}
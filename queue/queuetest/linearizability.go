@@ -0,0 +1,139 @@
+package queuetest
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+type opKind int
+
+const (
+	opEnqueue opKind = iota
+	opDequeue
+)
+
+// operation 是并发历史中的一次操作
+// call 和 ret 来自同一个全局递增的时钟，分别在调用之前和返回之后获取
+type operation struct {
+	goroutine int
+	kind      opKind
+	// value 对于入队是入队的元素；对于出队是返回的元素，仅在 ok 为 true 时有效
+	value int
+	// ok 为 false 表示入队时队列已满或者出队时队列为空
+	ok        bool
+	call, ret int64
+}
+
+func (op operation) String() string {
+	switch {
+	case op.kind == opEnqueue && op.ok:
+		return fmt.Sprintf("G%d: Enqueue(%d) [%d, %d]", op.goroutine, op.value, op.call, op.ret)
+	case op.kind == opEnqueue:
+		return fmt.Sprintf("G%d: Enqueue(%d) -> 已满 [%d, %d]", op.goroutine, op.value, op.call, op.ret)
+	case op.ok:
+		return fmt.Sprintf("G%d: Dequeue() -> %d [%d, %d]", op.goroutine, op.value, op.call, op.ret)
+	default:
+		return fmt.Sprintf("G%d: Dequeue() -> 为空 [%d, %d]", op.goroutine, op.call, op.ret)
+	}
+}
+
+// clock 为并发历史提供全局递增的逻辑时间
+type clock struct {
+	now atomic.Int64
+}
+
+func (c *clock) tick() int64 {
+	return c.now.Add(1)
+}
+
+// maxHistory 线性一致性检查使用位图记录已经线性化的操作，因此单次历史的操作数不能超过 64
+const maxHistory = 64
+
+// checkLinearizable 检查并发历史 ops 是否对容量为 capacity（0 表示无界）的 FIFO 队列线性一致
+// 使用 Wing & Gong 的回溯搜索：每一步尝试线性化一个"最小"的操作，
+// 即在所有尚未线性化的操作返回之前就已经被调用的操作，并用模型队列验证其结果；
+// 对已经验证失败的（已线性化集合, 模型状态）做记忆化剪枝
+func checkLinearizable(ops []operation, capacity int) bool {
+	if len(ops) > maxHistory {
+		panic(fmt.Sprintf("queuetest: 单次历史最多包含 %d 个操作，实际为 %d", maxHistory, len(ops)))
+	}
+	c := &checker{ops: ops, capacity: capacity, visited: make(map[string]struct{})}
+	return c.search(0, nil)
+}
+
+type checker struct {
+	ops      []operation
+	capacity int
+	visited  map[string]struct{}
+}
+
+func (c *checker) search(done uint64, state []int) bool {
+	if done == uint64(1)<<len(c.ops)-1 {
+		return true
+	}
+
+	key := stateKey(done, state)
+	if _, ok := c.visited[key]; ok {
+		return false
+	}
+	c.visited[key] = struct{}{}
+
+	minRet := int64(-1)
+	for i, op := range c.ops {
+		if done&(1<<i) == 0 && (minRet < 0 || op.ret < minRet) {
+			minRet = op.ret
+		}
+	}
+
+	for i, op := range c.ops {
+		if done&(1<<i) != 0 || op.call > minRet {
+			continue
+		}
+		next, ok := c.apply(op, state)
+		if ok && c.search(done|1<<i, next) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply 在模型队列 state 上执行 op，返回新的状态以及 op 的结果是否与模型一致
+func (c *checker) apply(op operation, state []int) ([]int, bool) {
+	full := c.capacity > 0 && len(state) >= c.capacity
+	switch {
+	case op.kind == opEnqueue && op.ok:
+		if full {
+			return nil, false
+		}
+		next := make([]int, len(state), len(state)+1)
+		copy(next, state)
+		return append(next, op.value), true
+	case op.kind == opEnqueue:
+		return state, full
+	case op.ok:
+		if len(state) == 0 || state[0] != op.value {
+			return nil, false
+		}
+		return state[1:], true
+	default:
+		return state, len(state) == 0
+	}
+}
+
+func stateKey(done uint64, state []int) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%x:", done)
+	for _, v := range state {
+		fmt.Fprintf(&sb, "%d,", v)
+	}
+	return sb.String()
+}
+
+func formatHistory(ops []operation) string {
+	lines := make([]string, len(ops))
+	for i, op := range ops {
+		lines[i] = op.String()
+	}
+	return strings.Join(lines, "\n  ")
+}
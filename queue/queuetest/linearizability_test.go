@@ -0,0 +1,87 @@
+package queuetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckLinearizable(t *testing.T) {
+	enq := func(v int, ok bool, call, ret int64) operation {
+		return operation{kind: opEnqueue, value: v, ok: ok, call: call, ret: ret}
+	}
+	deq := func(v int, ok bool, call, ret int64) operation {
+		return operation{kind: opDequeue, value: v, ok: ok, call: call, ret: ret}
+	}
+
+	testCases := []struct {
+		name     string
+		ops      []operation
+		capacity int
+		want     bool
+	}{
+		{
+			name: "空历史",
+			want: true,
+		},
+		{
+			name: "顺序执行的 FIFO",
+			ops:  []operation{enq(1, true, 1, 2), enq(2, true, 3, 4), deq(1, true, 5, 6), deq(2, true, 7, 8)},
+			want: true,
+		},
+		{
+			name: "顺序执行却后进先出",
+			ops:  []operation{enq(1, true, 1, 2), enq(2, true, 3, 4), deq(2, true, 5, 6)},
+			want: false,
+		},
+		{
+			name: "并发入队可以任意排序",
+			ops:  []operation{enq(1, true, 1, 4), enq(2, true, 2, 3), deq(1, true, 5, 6), deq(2, true, 7, 8)},
+			want: true,
+		},
+		{
+			name: "出队与入队重叠时可以先于入队返回为空",
+			ops:  []operation{enq(1, true, 1, 4), deq(0, false, 2, 3), deq(1, true, 5, 6)},
+			want: true,
+		},
+		{
+			name: "入队完成之后出队却返回为空",
+			ops:  []operation{enq(1, true, 1, 2), deq(0, false, 3, 4)},
+			want: false,
+		},
+		{
+			name: "出队了从未入队的元素",
+			ops:  []operation{enq(1, true, 1, 2), deq(3, true, 3, 4)},
+			want: false,
+		},
+		{
+			name: "同一个元素出队两次",
+			ops:  []operation{enq(1, true, 1, 2), deq(1, true, 3, 6), deq(1, true, 4, 5)},
+			want: false,
+		},
+		{
+			name:     "有界队列已满时入队失败",
+			ops:      []operation{enq(1, true, 1, 2), enq(2, false, 3, 4), deq(1, true, 5, 6)},
+			capacity: 1,
+			want:     true,
+		},
+		{
+			name:     "有界队列未满时入队失败",
+			ops:      []operation{enq(1, true, 1, 2), enq(2, false, 3, 4)},
+			capacity: 2,
+			want:     false,
+		},
+		{
+			name:     "超出容量",
+			ops:      []operation{enq(1, true, 1, 2), enq(2, true, 3, 4)},
+			capacity: 1,
+			want:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, checkLinearizable(tc.ops, tc.capacity))
+		})
+	}
+}
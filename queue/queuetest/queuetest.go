@@ -0,0 +1,311 @@
+// Package queuetest 提供 queue.Queue 和 queue.BlockingQueue 接口的一致性测试套件
+// 包括 FIFO 顺序、空/满时的错误、ctx 超时与取消的语义，
+// 以及对并发执行历史的线性一致性检查。
+// 队列的行为与元素类型无关，因此测试统一使用 int 实例化待测队列
+package queuetest
+
+import (
+	"errors"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"mkit/queue"
+)
+
+// Config 描述待测试的 queue.Queue 实现
+type Config struct {
+	// New 创建一个空队列，必须设置
+	New func() queue.Queue[int]
+	// Capacity 队列的容量，0 表示无界队列
+	Capacity int
+	// ErrEmpty 队列为空时 Dequeue 应当返回的错误，会使用 errors.Is 判断
+	// 为 nil 时只要求返回非 nil 的错误
+	ErrEmpty error
+	// ErrFull 队列已满时 Enqueue 应当返回的错误，会使用 errors.Is 判断
+	// 默认为 queue.ErrOutOfCapacity
+	ErrFull error
+	// Concurrent 为 true 表示队列可以被并发使用，此时会进行线性一致性检查和压力测试
+	Concurrent bool
+	// Goroutines 线性一致性检查中并发的 goroutine 数量，默认 4
+	Goroutines int
+	// OpsPerGoroutine 线性一致性检查中每个 goroutine 执行的操作数，默认 6
+	// Goroutines * OpsPerGoroutine 不能超过 64
+	OpsPerGoroutine int
+	// Rounds 线性一致性检查的轮数，默认 200
+	Rounds int
+	// Seed 随机数种子，为 0 时使用当前时间
+	Seed int64
+}
+
+func (cfg Config) withDefaults(t *testing.T) Config {
+	t.Helper()
+	if cfg.New == nil {
+		t.Fatal("queuetest: 必须设置 Config.New")
+	}
+	if cfg.ErrFull == nil {
+		cfg.ErrFull = queue.ErrOutOfCapacity
+	}
+	if cfg.Goroutines <= 0 {
+		cfg.Goroutines = 4
+	}
+	if cfg.OpsPerGoroutine <= 0 {
+		cfg.OpsPerGoroutine = 6
+	}
+	if cfg.Goroutines*cfg.OpsPerGoroutine > maxHistory {
+		t.Fatalf("queuetest: Goroutines * OpsPerGoroutine 不能超过 %d", maxHistory)
+	}
+	if cfg.Rounds <= 0 {
+		cfg.Rounds = 200
+	}
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	return cfg
+}
+
+// Run 运行 queue.Queue 的全部一致性测试
+func Run(t *testing.T, cfg Config) {
+	t.Helper()
+	cfg = cfg.withDefaults(t)
+
+	t.Run("FIFO", func(t *testing.T) {
+		q := cfg.New()
+		n := 100
+		if cfg.Capacity > 0 {
+			n = cfg.Capacity
+		}
+		for round := 0; round < 2; round++ {
+			for i := 0; i < n; i++ {
+				if err := q.Enqueue(i); err != nil {
+					t.Fatalf("Enqueue(%d) 返回错误: %v", i, err)
+				}
+			}
+			for i := 0; i < n; i++ {
+				v, err := q.Dequeue()
+				if err != nil || v != i {
+					t.Fatalf("Dequeue() = %d, %v，期望 %d", v, err, i)
+				}
+			}
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		q := cfg.New()
+		checkEmptyErr(t, cfg, q)
+		if err := q.Enqueue(1); err != nil {
+			t.Fatalf("Enqueue 返回错误: %v", err)
+		}
+		if _, err := q.Dequeue(); err != nil {
+			t.Fatalf("Dequeue 返回错误: %v", err)
+		}
+		checkEmptyErr(t, cfg, q)
+	})
+
+	if cfg.Capacity > 0 {
+		t.Run("Full", func(t *testing.T) {
+			q := cfg.New()
+			for i := 0; i < cfg.Capacity; i++ {
+				if err := q.Enqueue(i); err != nil {
+					t.Fatalf("Enqueue(%d) 返回错误: %v", i, err)
+				}
+			}
+			if err := q.Enqueue(cfg.Capacity); !errors.Is(err, cfg.ErrFull) {
+				t.Fatalf("队列已满时 Enqueue 应当返回 %v，实际为 %v", cfg.ErrFull, err)
+			}
+			// 出队一个元素之后可以继续入队，并且已满时入队的元素不会出现在队列中
+			if v, err := q.Dequeue(); err != nil || v != 0 {
+				t.Fatalf("Dequeue() = %d, %v，期望 0", v, err)
+			}
+			if err := q.Enqueue(-1); err != nil {
+				t.Fatalf("出队之后 Enqueue 返回错误: %v", err)
+			}
+			for i := 1; i < cfg.Capacity; i++ {
+				if v, err := q.Dequeue(); err != nil || v != i {
+					t.Fatalf("Dequeue() = %d, %v，期望 %d", v, err, i)
+				}
+			}
+			if v, err := q.Dequeue(); err != nil || v != -1 {
+				t.Fatalf("Dequeue() = %d, %v，期望 -1", v, err)
+			}
+		})
+	}
+
+	if !cfg.Concurrent {
+		return
+	}
+
+	t.Run("Linearizability", func(t *testing.T) {
+		r := rand.New(rand.NewSource(cfg.Seed))
+		for round := 0; round < cfg.Rounds; round++ {
+			q := cfg.New()
+			ops := runHistory(cfg.Goroutines, cfg.OpsPerGoroutine, r, func(g int, enqueue bool, v int) (int, bool) {
+				if enqueue {
+					err := q.Enqueue(v)
+					if err != nil && !errors.Is(err, cfg.ErrFull) {
+						t.Errorf("Enqueue 返回了非预期的错误: %v", err)
+					}
+					return v, err == nil
+				}
+				v, err := q.Dequeue()
+				if err != nil && cfg.ErrEmpty != nil && !errors.Is(err, cfg.ErrEmpty) {
+					t.Errorf("Dequeue 返回了非预期的错误: %v", err)
+				}
+				return v, err == nil
+			})
+			if !checkLinearizable(ops, cfg.Capacity) {
+				t.Fatalf("第 %d 轮的并发历史不满足线性一致性，Seed = %d\n  %s", round, cfg.Seed, formatHistory(ops))
+			}
+		}
+	})
+
+	t.Run("Stress", func(t *testing.T) {
+		q := cfg.New()
+		stress(t, 8, 8, 2000, func(v int) bool {
+			return q.Enqueue(v) == nil
+		}, func() (int, bool) {
+			v, err := q.Dequeue()
+			return v, err == nil
+		})
+	})
+}
+
+func checkEmptyErr(t *testing.T, cfg Config, q queue.Queue[int]) {
+	t.Helper()
+	_, err := q.Dequeue()
+	if err == nil {
+		t.Fatal("队列为空时 Dequeue 应当返回错误")
+	}
+	if cfg.ErrEmpty != nil && !errors.Is(err, cfg.ErrEmpty) {
+		t.Fatalf("队列为空时 Dequeue 应当返回 %v，实际为 %v", cfg.ErrEmpty, err)
+	}
+}
+
+// runHistory 启动 goroutines 个 goroutine，每个随机执行 opsPerGoroutine 次入队或出队，
+// 返回记录下来的并发历史。入队的元素各不相同
+// do 执行一次操作，返回入队的元素或者出队得到的元素，以及操作是否成功
+func runHistory(goroutines, opsPerGoroutine int, r *rand.Rand, do func(g int, enqueue bool, v int) (int, bool)) []operation {
+	var (
+		c       clock
+		wg      sync.WaitGroup
+		nextVal atomic.Int64
+	)
+	histories := make([][]operation, goroutines)
+	plans := make([][]bool, goroutines)
+	for g := range plans {
+		plans[g] = make([]bool, opsPerGoroutine)
+		for i := range plans[g] {
+			plans[g][i] = r.Intn(2) == 0
+		}
+	}
+
+	start := make(chan struct{})
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			<-start
+			for _, enqueue := range plans[g] {
+				op := operation{goroutine: g, kind: opDequeue}
+				var v int
+				if enqueue {
+					op.kind = opEnqueue
+					v = int(nextVal.Add(1))
+				}
+				op.call = c.tick()
+				op.value, op.ok = do(g, enqueue, v)
+				op.ret = c.tick()
+				histories[g] = append(histories[g], op)
+			}
+		}(g)
+	}
+	close(start)
+	wg.Wait()
+
+	ops := make([]operation, 0, goroutines*opsPerGoroutine)
+	for _, h := range histories {
+		ops = append(ops, h...)
+	}
+	return ops
+}
+
+// stress 使用 producers 个生产者各自入队 perProducer 个元素，consumers 个消费者并发出队，
+// 验证每个元素恰好被消费一次，并且每个消费者看到的同一生产者的元素保持入队顺序
+// enqueue 在入队失败（例如队列已满）时返回 false，会在让出 CPU 之后重试
+// dequeue 返回出队的元素以及是否成功
+func stress(t *testing.T, producers, consumers, perProducer int,
+	enqueue func(v int) bool, dequeue func() (int, bool)) {
+	t.Helper()
+	total := producers * perProducer
+	consumed := make([]atomic.Int32, total)
+	var remaining atomic.Int64
+	remaining.Store(int64(total))
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				for !enqueue(p*perProducer + i) {
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	errCh := make(chan string, consumers)
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+			for remaining.Load() > 0 {
+				v, ok := dequeue()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				if v < 0 || v >= total {
+					errCh <- "出队了从未入队的元素"
+					return
+				}
+				p, seq := v/perProducer, v%perProducer
+				if seq <= last[p] {
+					errCh <- "同一生产者的元素没有按照入队顺序出队"
+					return
+				}
+				last[p] = seq
+				consumed[v].Add(1)
+				remaining.Add(-1)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatalf("压力测试超时，剩余 %d 个元素未被消费", remaining.Load())
+	}
+
+	close(errCh)
+	for msg := range errCh {
+		t.Error(msg)
+	}
+	for v := range consumed {
+		if n := consumed[v].Load(); n != 1 {
+			t.Fatalf("元素 %d 被消费了 %d 次，期望 1 次", v, n)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package queuetest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"mkit/queue"
+)
+
+// sliceQueue 是基于切片的有界队列，用于验证测试套件本身
+type sliceQueue struct {
+	mu       sync.Mutex
+	elems    []int
+	capacity int
+}
+
+func (q *sliceQueue) Enqueue(t int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.capacity > 0 && len(q.elems) >= q.capacity {
+		return queue.ErrOutOfCapacity
+	}
+	q.elems = append(q.elems, t)
+	return nil
+}
+
+func (q *sliceQueue) Dequeue() (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.elems) == 0 {
+		return 0, errEmpty
+	}
+	t := q.elems[0]
+	q.elems = q.elems[1:]
+	return t, nil
+}
+
+var errEmpty = errors.New("queuetest: 队列为空")
+
+// chanQueue 是基于 channel 的有界阻塞队列，用于验证测试套件本身
+type chanQueue struct {
+	ch chan int
+}
+
+func (q *chanQueue) Enqueue(ctx context.Context, t int) error {
+	select {
+	case q.ch <- t:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *chanQueue) Dequeue(ctx context.Context) (int, error) {
+	select {
+	case t := <-q.ch:
+		return t, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Unbounded", func(t *testing.T) {
+		Run(t, Config{
+			New:        func() queue.Queue[int] { return &sliceQueue{} },
+			Concurrent: true,
+		})
+	})
+	t.Run("Bounded", func(t *testing.T) {
+		Run(t, Config{
+			New:        func() queue.Queue[int] { return &sliceQueue{capacity: 3} },
+			Capacity:   3,
+			ErrEmpty:   errEmpty,
+			Concurrent: true,
+		})
+	})
+}
+
+func TestRunBlocking(t *testing.T) {
+	RunBlocking(t, BlockingConfig{
+		New:      func() queue.BlockingQueue[int] { return &chanQueue{ch: make(chan int, 100)} },
+		Capacity: 100,
+	})
+	RunBlocking(t, BlockingConfig{
+		New:      func() queue.BlockingQueue[int] { return &chanQueue{ch: make(chan int, 2)} },
+		Capacity: 2,
+	})
+}
@@ -0,0 +1,130 @@
+package queue
+
+import "mkit/internal/errs"
+
+var (
+	_ Queue[any] = &RingBuffer[any]{}
+)
+
+type ringBufferOptions struct {
+	overwrite bool
+}
+
+// RingBufferOption 创建 RingBuffer 时的可选配置
+type RingBufferOption func(opts *ringBufferOptions)
+
+// WithOverwrite 缓冲区已满时，入队会覆盖最旧的元素，而不是返回 ErrOutOfCapacity
+func WithOverwrite() RingBufferOption {
+	return func(opts *ringBufferOptions) {
+		opts.overwrite = true
+	}
+}
+
+// RingBuffer 固定容量的环形缓冲区，按照 FIFO 的顺序出队
+// 默认在已满时拒绝入队，通过 WithOverwrite 可以改为覆盖最旧的元素，
+// 适合只保留最近 N 个元素的场景
+// RingBuffer 不是并发安全的
+type RingBuffer[T any] struct {
+	buf []T
+	// head 为最旧的元素在 buf 中的下标
+	head      int
+	size      int
+	overwrite bool
+}
+
+// NewRingBuffer 创建一个容量为 capacity 的环形缓冲区
+// capacity 小于等于 0 时，缓冲区无法存放任何元素
+func NewRingBuffer[T any](capacity int, opts ...RingBufferOption) *RingBuffer[T] {
+	var o ringBufferOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &RingBuffer[T]{
+		buf:       make([]T, max(capacity, 0)),
+		overwrite: o.overwrite,
+	}
+}
+
+// physical 将逻辑下标转化为 buf 中的下标
+func (r *RingBuffer[T]) physical(index int) int {
+	return (r.head + index) % len(r.buf)
+}
+
+// Enqueue 在队尾放入元素
+// 缓冲区已满时，覆盖模式下会丢弃最旧的元素，否则返回 ErrOutOfCapacity
+func (r *RingBuffer[T]) Enqueue(t T) error {
+	if len(r.buf) == 0 {
+		return ErrOutOfCapacity
+	}
+	if r.size == len(r.buf) {
+		if !r.overwrite {
+			return ErrOutOfCapacity
+		}
+		r.buf[r.head] = t
+		r.head = r.physical(1)
+		return nil
+	}
+	r.buf[r.physical(r.size)] = t
+	r.size++
+	return nil
+}
+
+// Dequeue 取出最旧的元素，缓冲区为空时返回 ErrEmptyQueue
+func (r *RingBuffer[T]) Dequeue() (T, error) {
+	var zeroValue T
+	if r.size == 0 {
+		return zeroValue, ErrEmptyQueue
+	}
+	t := r.buf[r.head]
+	r.buf[r.head] = zeroValue
+	r.head = r.physical(1)
+	r.size--
+	return t, nil
+}
+
+// Get 返回第 index 旧的元素，index 为 0 表示最旧的元素
+// 在下标超出范围的情况下，返回错误
+func (r *RingBuffer[T]) Get(index int) (T, error) {
+	if index < 0 || index >= r.size {
+		var zeroValue T
+		return zeroValue, errs.NewErrIndexOutOfRange(r.size, index)
+	}
+	return r.buf[r.physical(index)], nil
+}
+
+// Len 返回元素个数
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap 返回容量
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Full 判断缓冲区是否已满
+func (r *RingBuffer[T]) Full() bool {
+	return r.size == len(r.buf)
+}
+
+// Clear 删除所有元素
+func (r *RingBuffer[T]) Clear() {
+	clear(r.buf)
+	r.head, r.size = 0, 0
+}
+
+// AsSlice 按照从旧到新的顺序返回所有元素
+// 在没有元素的情况下，返回一个长度和容量都为 0 的切片
+// AsSlice 每次调用都会返回一个全新的切片
+func (r *RingBuffer[T]) AsSlice() []T {
+	res := make([]T, 0, r.size)
+	if r.size == 0 {
+		return res
+	}
+	end := r.head + r.size
+	if end <= len(r.buf) {
+		return append(res, r.buf[r.head:end]...)
+	}
+	res = append(res, r.buf[r.head:]...)
+	return append(res, r.buf[:end-len(r.buf)]...)
+}
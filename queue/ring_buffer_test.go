@@ -0,0 +1,92 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingBuffer_Reject(t *testing.T) {
+	r := NewRingBuffer[int](3)
+	assert.Equal(t, 3, r.Cap())
+	assert.Equal(t, []int{}, r.AsSlice())
+	_, err := r.Dequeue()
+	assert.ErrorIs(t, err, ErrEmptyQueue)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, r.Enqueue(i))
+	}
+	assert.True(t, r.Full())
+	assert.ErrorIs(t, r.Enqueue(4), ErrOutOfCapacity)
+	assert.Equal(t, []int{1, 2, 3}, r.AsSlice())
+
+	// 出队之后写入位置回绕到缓冲区的开头
+	v, err := r.Dequeue()
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+	require.NoError(t, r.Enqueue(4))
+	assert.Equal(t, []int{2, 3, 4}, r.AsSlice())
+}
+
+func TestRingBuffer_Overwrite(t *testing.T) {
+	r := NewRingBuffer[int](3, WithOverwrite())
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, r.Enqueue(i))
+	}
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, []int{5, 6, 7}, r.AsSlice())
+
+	testCases := []struct {
+		name    string
+		index   int
+		want    int
+		wantErr bool
+	}{
+		{name: "最旧", index: 0, want: 5},
+		{name: "最新", index: 2, want: 7},
+		{name: "负数下标", index: -1, wantErr: true},
+		{name: "下标等于长度", index: 3, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := r.Get(tc.index)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, v)
+		})
+	}
+
+	v, err := r.Dequeue()
+	require.NoError(t, err)
+	assert.Equal(t, 5, v)
+	require.NoError(t, r.Enqueue(8))
+	require.NoError(t, r.Enqueue(9))
+	assert.Equal(t, []int{7, 8, 9}, r.AsSlice())
+
+	r.Clear()
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, []int{}, r.AsSlice())
+	require.NoError(t, r.Enqueue(10))
+	assert.Equal(t, []int{10}, r.AsSlice())
+}
+
+func TestRingBuffer_ZeroCapacity(t *testing.T) {
+	for _, opts := range [][]RingBufferOption{nil, {WithOverwrite()}} {
+		r := NewRingBuffer[int](0, opts...)
+		assert.ErrorIs(t, r.Enqueue(1), ErrOutOfCapacity)
+		assert.Equal(t, []int{}, r.AsSlice())
+	}
+}
+
+func TestRingBuffer_ReleasesElements(t *testing.T) {
+	x := 1
+	r := NewRingBuffer[*int](2)
+	require.NoError(t, r.Enqueue(&x))
+	_, err := r.Dequeue()
+	require.NoError(t, err)
+	assert.Equal(t, []*int{nil, nil}, r.buf)
+}
@@ -0,0 +1,133 @@
+package set
+
+import (
+	"sync"
+	"unsafe"
+)
+
+var (
+	_ Set[int] = &ConcurrentSet[int]{}
+)
+
+// ConcurrentSet 并发安全的集合，使用读写锁保护内部的集合
+type ConcurrentSet[T any] struct {
+	mu sync.RWMutex
+	s  Set[T]
+}
+
+// NewConcurrentSet 使用 s 创建并发安全的集合，之后不能再直接使用 s
+func NewConcurrentSet[T any](s Set[T]) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{s: s}
+}
+
+// Add 添加元素，元素已经存在时不做任何操作
+func (c *ConcurrentSet[T]) Add(t T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s.Add(t)
+}
+
+// Delete 删除元素，元素不存在时不做任何操作
+func (c *ConcurrentSet[T]) Delete(t T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s.Delete(t)
+}
+
+// Contains 判断元素是否存在
+func (c *ConcurrentSet[T]) Contains(t T) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Contains(t)
+}
+
+// Len 返回元素个数
+func (c *ConcurrentSet[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Len()
+}
+
+// Keys 返回所有元素组成的切片
+func (c *ConcurrentSet[T]) Keys() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Keys()
+}
+
+// Range 遍历所有元素，遍历期间持有读锁，因此 fn 中不能修改该集合
+func (c *ConcurrentSet[T]) Range(fn func(t T) error) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Range(fn)
+}
+
+// Clone 返回一个并发安全的副本
+func (c *ConcurrentSet[T]) Clone() Set[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return NewConcurrentSet(c.s.Clone())
+}
+
+// read 在持有读锁的情况下，使用 c 内部的集合与 other 调用 fn，不会复制集合
+// other 也是 ConcurrentSet 时直接使用它内部的集合：other 就是 c 本身时只加一次读锁，
+// 否则按照地址顺序对两者加读锁，避免两个集合互相运算时，因为等待中的写锁而死锁
+func (c *ConcurrentSet[T]) read(other Set[T], fn func(s, other Set[T])) {
+	o, ok := other.(*ConcurrentSet[T])
+	switch {
+	case !ok:
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		fn(c.s, other)
+	case o == c:
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		fn(c.s, c.s)
+	default:
+		first, second := c, o
+		if uintptr(unsafe.Pointer(o)) < uintptr(unsafe.Pointer(c)) {
+			first, second = o, c
+		}
+		first.mu.RLock()
+		defer first.mu.RUnlock()
+		second.mu.RLock()
+		defer second.mu.RUnlock()
+		fn(c.s, o.s)
+	}
+}
+
+// Union 返回两个集合的并集，结果也是并发安全的
+func (c *ConcurrentSet[T]) Union(other Set[T]) Set[T] {
+	var res Set[T]
+	c.read(other, func(s, other Set[T]) {
+		res = s.Union(other)
+	})
+	return NewConcurrentSet(res)
+}
+
+// Intersect 返回两个集合的交集，结果也是并发安全的
+func (c *ConcurrentSet[T]) Intersect(other Set[T]) Set[T] {
+	var res Set[T]
+	c.read(other, func(s, other Set[T]) {
+		res = s.Intersect(other)
+	})
+	return NewConcurrentSet(res)
+}
+
+// Difference 返回只在当前集合中，而不在 other 中的元素组成的集合，结果也是并发安全的
+func (c *ConcurrentSet[T]) Difference(other Set[T]) Set[T] {
+	var res Set[T]
+	c.read(other, func(s, other Set[T]) {
+		res = s.Difference(other)
+	})
+	return NewConcurrentSet(res)
+}
+
+// IsSubset 判断当前集合是否是 other 的子集
+func (c *ConcurrentSet[T]) IsSubset(other Set[T]) bool {
+	var res bool
+	c.read(other, func(s, other Set[T]) {
+		res = s.IsSubset(other)
+	})
+	return res
+}
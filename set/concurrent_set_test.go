@@ -0,0 +1,79 @@
+package set
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentSet_Concurrent(t *testing.T) {
+	s := NewConcurrentSet[int](NewMapSet[int](0))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				s.Add(g*1000 + i)
+				s.Contains(i)
+				if i%50 == 0 {
+					// 与自身进行集合运算不会死锁
+					s.Union(s)
+					s.IsSubset(s)
+				}
+				if i%2 == 1 {
+					s.Delete(g*1000 + i)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 8*250, s.Len())
+	assert.True(t, s.IsSubset(s))
+	assert.Equal(t, 0, s.Difference(s).Len())
+}
+
+// 两个集合互相进行集合运算，同时有写入，不会因为加锁顺序而死锁
+func TestConcurrentSet_Cross(t *testing.T) {
+	a := NewConcurrentSet[int](NewMapSet[int](0))
+	b := NewConcurrentSet[int](NewMapSet[int](0))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			x, y := a, b
+			if g%2 == 1 {
+				x, y = b, a
+			}
+			for i := 0; i < 300; i++ {
+				x.Add(i)
+				x.IsSubset(y)
+				x.Union(y)
+				x.Intersect(y)
+				x.Difference(y)
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.True(t, a.IsSubset(b))
+	assert.Equal(t, 300, a.Union(b).Len())
+	assert.Equal(t, 300, a.Intersect(b).Len())
+}
+
+// IsSubset 只读取元素，不会复制集合，分配次数与集合大小无关
+func TestConcurrentSet_IsSubsetAllocs(t *testing.T) {
+	allocs := func(n int) float64 {
+		s := NewMapSet[int](n)
+		for i := 0; i < n; i++ {
+			s.Add(i)
+		}
+		c := NewConcurrentSet[int](s)
+		other := s.Clone()
+		return testing.AllocsPerRun(10, func() {
+			c.IsSubset(other)
+		})
+	}
+	assert.Equal(t, allocs(10), allocs(10000))
+}
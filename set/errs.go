@@ -0,0 +1,6 @@
+package set
+
+import "errors"
+
+// errNotSubset 用于在 Range 的回调中提前结束遍历，不会返回给调用者
+var errNotSubset = errors.New("mkit: 不是子集")
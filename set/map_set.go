@@ -0,0 +1,107 @@
+package set
+
+var (
+	_ Set[int] = &MapSet[int]{}
+)
+
+// MapSet 基于 map 实现的集合，遍历顺序是不确定的
+type MapSet[T comparable] struct {
+	m map[T]struct{}
+}
+
+// NewMapSet 创建一个预留了 size 个元素空间的集合
+func NewMapSet[T comparable](size int) *MapSet[T] {
+	return &MapSet[T]{m: make(map[T]struct{}, size)}
+}
+
+// NewMapSetOf 使用 ts 中的元素创建集合，重复的元素只会保留一个
+func NewMapSetOf[T comparable](ts []T) *MapSet[T] {
+	s := NewMapSet[T](len(ts))
+	for _, t := range ts {
+		s.Add(t)
+	}
+	return s
+}
+
+// Add 添加元素，元素已经存在时不做任何操作
+func (s *MapSet[T]) Add(t T) {
+	if s.m == nil {
+		// 兼容零值 MapSet
+		s.m = make(map[T]struct{})
+	}
+	s.m[t] = struct{}{}
+}
+
+// Delete 删除元素，元素不存在时不做任何操作
+func (s *MapSet[T]) Delete(t T) {
+	delete(s.m, t)
+}
+
+// Contains 判断元素是否存在
+func (s *MapSet[T]) Contains(t T) bool {
+	_, ok := s.m[t]
+	return ok
+}
+
+// Len 返回元素个数
+func (s *MapSet[T]) Len() int {
+	return len(s.m)
+}
+
+// Keys 返回所有元素组成的切片，顺序是不确定的
+func (s *MapSet[T]) Keys() []T {
+	res := make([]T, 0, len(s.m))
+	for t := range s.m {
+		res = append(res, t)
+	}
+	return res
+}
+
+// Range 遍历所有元素，顺序是不确定的
+func (s *MapSet[T]) Range(fn func(t T) error) error {
+	for t := range s.m {
+		if err := fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone 返回一个副本
+func (s *MapSet[T]) Clone() Set[T] {
+	return s.clone()
+}
+
+func (s *MapSet[T]) clone() *MapSet[T] {
+	res := NewMapSet[T](len(s.m))
+	for t := range s.m {
+		res.m[t] = struct{}{}
+	}
+	return res
+}
+
+// Union 返回两个集合的并集
+func (s *MapSet[T]) Union(other Set[T]) Set[T] {
+	res := s.clone()
+	addAll[T](res, other)
+	return res
+}
+
+// Intersect 返回两个集合的交集
+func (s *MapSet[T]) Intersect(other Set[T]) Set[T] {
+	res := NewMapSet[T](0)
+	intersect[T](res, s, other)
+	return res
+}
+
+// Difference 返回只在当前集合中，而不在 other 中的元素组成的集合
+func (s *MapSet[T]) Difference(other Set[T]) Set[T] {
+	res := NewMapSet[T](0)
+	difference[T](res, s, other)
+	return res
+}
+
+// IsSubset 判断当前集合是否是 other 的子集
+func (s *MapSet[T]) IsSubset(other Set[T]) bool {
+	return isSubset[T](s, other)
+}
@@ -0,0 +1,79 @@
+// Package set 提供泛型集合
+// MapSet 基于 map 实现，要求元素是 comparable；
+// TreeSet 基于比较函数实现，元素按照从小到大的顺序排列；
+// ConcurrentSet 为任意 Set 实现加上读写锁，使其可以被并发使用。
+// 集合运算返回新的集合，类型与调用者相同，不会修改参与运算的集合
+package set
+
+// Set 集合接口
+type Set[T any] interface {
+	// Add 添加元素，元素已经存在时不做任何操作
+	Add(t T)
+	// Delete 删除元素，元素不存在时不做任何操作
+	Delete(t T)
+	// Contains 判断元素是否存在
+	Contains(t T) bool
+	// Len 返回元素个数
+	Len() int
+	// Keys 返回所有元素组成的切片，每次调用都会返回一个全新的切片
+	// 在没有元素的情况下，返回一个长度和容量都为 0 的切片
+	Keys() []T
+	// Range 遍历所有元素，fn 返回错误时停止遍历并返回该错误
+	// 遍历的顺序取决于具体实现，遍历过程中不能修改集合
+	Range(fn func(t T) error) error
+	// Clone 返回一个副本，修改副本不会影响原集合
+	Clone() Set[T]
+	// Union 返回两个集合的并集
+	Union(other Set[T]) Set[T]
+	// Intersect 返回两个集合的交集
+	Intersect(other Set[T]) Set[T]
+	// Difference 返回只在当前集合中，而不在 other 中的元素组成的集合
+	Difference(other Set[T]) Set[T]
+	// IsSubset 判断当前集合是否是 other 的子集
+	IsSubset(other Set[T]) bool
+}
+
+// addAll 把 src 中的元素全部加入 dst
+func addAll[T any](dst, src Set[T]) {
+	_ = src.Range(func(t T) error {
+		dst.Add(t)
+		return nil
+	})
+}
+
+// intersect 把 a 与 b 的交集加入 dst，遍历其中较小的集合
+func intersect[T any](dst, a, b Set[T]) {
+	if b.Len() < a.Len() {
+		a, b = b, a
+	}
+	_ = a.Range(func(t T) error {
+		if b.Contains(t) {
+			dst.Add(t)
+		}
+		return nil
+	})
+}
+
+// difference 把只在 a 中而不在 b 中的元素加入 dst
+func difference[T any](dst, a, b Set[T]) {
+	_ = a.Range(func(t T) error {
+		if !b.Contains(t) {
+			dst.Add(t)
+		}
+		return nil
+	})
+}
+
+// isSubset 判断 a 是否是 b 的子集
+func isSubset[T any](a, b Set[T]) bool {
+	if a.Len() > b.Len() {
+		return false
+	}
+	err := a.Range(func(t T) error {
+		if !b.Contains(t) {
+			return errNotSubset
+		}
+		return nil
+	})
+	return err == nil
+}
@@ -0,0 +1,130 @@
+package set
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// implementations 返回所有待测试的 Set 实现
+func implementations() map[string]func(ts []int) Set[int] {
+	return map[string]func(ts []int) Set[int]{
+		"MapSet": func(ts []int) Set[int] { return NewMapSetOf(ts) },
+		"TreeSet": func(ts []int) Set[int] {
+			return NewTreeSetOf(ts, cmp.Compare[int])
+		},
+		"ConcurrentSet": func(ts []int) Set[int] {
+			return NewConcurrentSet[int](NewMapSetOf(ts))
+		},
+	}
+}
+
+func sortedKeys(s Set[int]) []int {
+	keys := s.Keys()
+	slices.Sort(keys)
+	return keys
+}
+
+func TestSet_Basic(t *testing.T) {
+	for name, newSet := range implementations() {
+		t.Run(name, func(t *testing.T) {
+			s := newSet([]int{3, 1, 3, 2})
+			assert.Equal(t, 3, s.Len())
+			assert.Equal(t, []int{1, 2, 3}, sortedKeys(s))
+
+			s.Add(4)
+			s.Add(1)
+			assert.Equal(t, 4, s.Len())
+			assert.True(t, s.Contains(4))
+
+			s.Delete(2)
+			s.Delete(10)
+			assert.False(t, s.Contains(2))
+			assert.Equal(t, []int{1, 3, 4}, sortedKeys(s))
+
+			visited := 0
+			err := s.Range(func(t int) error {
+				visited++
+				return errNotSubset
+			})
+			assert.Equal(t, errNotSubset, err)
+			assert.Equal(t, 1, visited)
+
+			clone := s.Clone()
+			clone.Add(100)
+			assert.False(t, s.Contains(100))
+			assert.IsType(t, s, clone)
+
+			empty := newSet(nil)
+			assert.Equal(t, []int{}, empty.Keys())
+		})
+	}
+}
+
+func TestSet_Algebra(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a, b           []int
+		wantUnion      []int
+		wantIntersect  []int
+		wantDifference []int
+		wantSubset     bool
+	}{
+		{
+			name:           "无交集",
+			a:              []int{1, 2},
+			b:              []int{3, 4},
+			wantUnion:      []int{1, 2, 3, 4},
+			wantIntersect:  []int{},
+			wantDifference: []int{1, 2},
+		},
+		{
+			name:           "有交集",
+			a:              []int{1, 2, 3},
+			b:              []int{2, 3, 4, 5},
+			wantUnion:      []int{1, 2, 3, 4, 5},
+			wantIntersect:  []int{2, 3},
+			wantDifference: []int{1},
+		},
+		{
+			name:           "子集",
+			a:              []int{2, 3},
+			b:              []int{1, 2, 3},
+			wantUnion:      []int{1, 2, 3},
+			wantIntersect:  []int{2, 3},
+			wantDifference: []int{},
+			wantSubset:     true,
+		},
+		{
+			name:           "空集",
+			a:              []int{},
+			b:              []int{1},
+			wantUnion:      []int{1},
+			wantIntersect:  []int{},
+			wantDifference: []int{},
+			wantSubset:     true,
+		},
+	}
+	impls := implementations()
+	for name, newSet := range impls {
+		// 参与运算的另一个集合使用不同的实现
+		for otherName, newOther := range impls {
+			for _, tc := range testCases {
+				t.Run(name+"-"+otherName+"-"+tc.name, func(t *testing.T) {
+					a, b := newSet(tc.a), newOther(tc.b)
+					union := a.Union(b)
+					assert.IsType(t, a, union)
+					assert.Equal(t, tc.wantUnion, sortedKeys(union))
+					assert.Equal(t, tc.wantIntersect, sortedKeys(a.Intersect(b)))
+					assert.Equal(t, tc.wantDifference, sortedKeys(a.Difference(b)))
+					assert.Equal(t, tc.wantSubset, a.IsSubset(b))
+					// 集合运算不会修改参与运算的集合
+					assert.Equal(t, len(tc.a), a.Len())
+					assert.Equal(t, len(tc.b), b.Len())
+				})
+			}
+		}
+	}
+}
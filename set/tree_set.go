@@ -0,0 +1,103 @@
+package set
+
+import "mkit/list"
+
+var (
+	_ Set[int] = &TreeSet[int]{}
+)
+
+// TreeSet 基于比较函数实现的有序集合，按照从小到大的顺序遍历
+// cmp 返回 0 的两个元素被视为同一个元素
+type TreeSet[T any] struct {
+	l   *list.SortedList[T]
+	cmp func(a, b T) int
+}
+
+// NewTreeSet 创建一个按照 cmp 排序的集合
+func NewTreeSet[T any](cmp func(a, b T) int) *TreeSet[T] {
+	return &TreeSet[T]{l: list.NewSortedList(cmp), cmp: cmp}
+}
+
+// NewTreeSetOf 使用 ts 中的元素创建集合，重复的元素只会保留第一个
+func NewTreeSetOf[T any](ts []T, cmp func(a, b T) int) *TreeSet[T] {
+	s := NewTreeSet(cmp)
+	for _, t := range ts {
+		s.Add(t)
+	}
+	return s
+}
+
+// Add 添加元素，元素已经存在时不做任何操作
+func (s *TreeSet[T]) Add(t T) {
+	if !s.l.Contains(t) {
+		s.l.Insert(t)
+	}
+}
+
+// Delete 删除元素，元素不存在时不做任何操作
+func (s *TreeSet[T]) Delete(t T) {
+	s.l.Remove(t)
+}
+
+// Contains 判断元素是否存在
+func (s *TreeSet[T]) Contains(t T) bool {
+	return s.l.Contains(t)
+}
+
+// Len 返回元素个数
+func (s *TreeSet[T]) Len() int {
+	return s.l.Len()
+}
+
+// Keys 按照从小到大的顺序返回所有元素
+func (s *TreeSet[T]) Keys() []T {
+	return s.l.AsSlice()
+}
+
+// Range 按照从小到大的顺序遍历所有元素
+func (s *TreeSet[T]) Range(fn func(t T) error) error {
+	return s.l.Range(func(index int, t T) error {
+		return fn(t)
+	})
+}
+
+// Floor 返回小于等于 t 的最大元素，不存在时返回 false
+func (s *TreeSet[T]) Floor(t T) (T, bool) {
+	return s.l.Floor(t)
+}
+
+// Ceiling 返回大于等于 t 的最小元素，不存在时返回 false
+func (s *TreeSet[T]) Ceiling(t T) (T, bool) {
+	return s.l.Ceiling(t)
+}
+
+// Clone 返回一个副本
+func (s *TreeSet[T]) Clone() Set[T] {
+	return NewTreeSetOf(s.Keys(), s.cmp)
+}
+
+// Union 返回两个集合的并集
+func (s *TreeSet[T]) Union(other Set[T]) Set[T] {
+	res := s.Clone()
+	addAll[T](res, other)
+	return res
+}
+
+// Intersect 返回两个集合的交集
+func (s *TreeSet[T]) Intersect(other Set[T]) Set[T] {
+	res := NewTreeSet(s.cmp)
+	intersect[T](res, s, other)
+	return res
+}
+
+// Difference 返回只在当前集合中，而不在 other 中的元素组成的集合
+func (s *TreeSet[T]) Difference(other Set[T]) Set[T] {
+	res := NewTreeSet(s.cmp)
+	difference[T](res, s, other)
+	return res
+}
+
+// IsSubset 判断当前集合是否是 other 的子集
+func (s *TreeSet[T]) IsSubset(other Set[T]) bool {
+	return isSubset[T](s, other)
+}
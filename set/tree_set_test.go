@@ -0,0 +1,42 @@
+package set
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeSet_Order(t *testing.T) {
+	s := NewTreeSetOf([]int{5, 1, 4, 1, 3}, cmp.Compare[int])
+	assert.Equal(t, []int{1, 3, 4, 5}, s.Keys())
+
+	var visited []int
+	_ = s.Range(func(t int) error {
+		visited = append(visited, t)
+		return nil
+	})
+	assert.Equal(t, []int{1, 3, 4, 5}, visited)
+
+	floor, ok := s.Floor(2)
+	assert.True(t, ok)
+	assert.Equal(t, 1, floor)
+	ceiling, ok := s.Ceiling(6)
+	assert.False(t, ok)
+	assert.Equal(t, 0, ceiling)
+
+	// 集合运算的结果保持有序
+	assert.Equal(t, []int{0, 1, 3, 4, 5, 9}, s.Union(NewMapSetOf([]int{9, 0})).Keys())
+}
+
+func TestTreeSet_Comparator(t *testing.T) {
+	// 忽略大小写，比较结果为 0 的元素被视为同一个元素，保留先加入的那个
+	s := NewTreeSetOf([]string{"Go", "go", "Rust", "GO"}, func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	assert.Equal(t, []string{"Go", "Rust"}, s.Keys())
+	assert.True(t, s.Contains("RUST"))
+	s.Delete("gO")
+	assert.Equal(t, []string{"Rust"}, s.Keys())
+}
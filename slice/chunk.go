@@ -0,0 +1,102 @@
+package slice
+
+import "mkit/internal/errs"
+
+// Chunk 将 src 按照 size 个元素一组切分，最后一组可能不足 size 个
+// 每一组都是 src 的子切片并且容量等于长度，向其中 append 不会覆盖 src 中的元素
+// size 小于等于 0 时返回错误
+func Chunk[T any](src []T, size int) ([][]T, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize("size", size)
+	}
+	res := make([][]T, 0, (len(src)+size-1)/size)
+	for i := 0; i < len(src); i += size {
+		end := min(i+size, len(src))
+		res = append(res, src[i:end:end])
+	}
+	return res, nil
+}
+
+// Window 返回 src 上长度为 size 的滑动窗口，相邻窗口的起点相差 step
+// 只返回完整的窗口，len(src) < size 时返回一个长度为 0 的切片
+// 每一个窗口都是 src 的子切片并且容量等于长度
+// size 或者 step 小于等于 0 时返回错误
+func Window[T any](src []T, size, step int) ([][]T, error) {
+	if size <= 0 {
+		return nil, errs.NewErrInvalidSize("size", size)
+	}
+	if step <= 0 {
+		return nil, errs.NewErrInvalidSize("step", step)
+	}
+	res := [][]T{}
+	for i := 0; i+size <= len(src); i += step {
+		res = append(res, src[i:i+size:i+size])
+	}
+	return res, nil
+}
+
+// Flatten 将多个切片按顺序拼接为一个新切片
+func Flatten[T any](src [][]T) []T {
+	n := 0
+	for _, s := range src {
+		n += len(s)
+	}
+	res := make([]T, 0, n)
+	for _, s := range src {
+		res = append(res, s...)
+	}
+	return res
+}
+
+// FlatMap 对每个元素应用 f，并将得到的切片按顺序拼接为一个新切片
+func FlatMap[T, U any](src []T, f func(t T) []U) []U {
+	res := make([]U, 0, len(src))
+	for _, v := range src {
+		res = append(res, f(v)...)
+	}
+	return res
+}
+
+// Interleave 轮流从每个切片中取出一个元素，较短的切片取完之后继续轮流取剩余的切片
+// 例如 Interleave([]int{1, 2, 3}, []int{4}, []int{5, 6}) 返回 [1 4 5 2 6 3]
+func Interleave[T any](srcs ...[]T) []T {
+	n, longest := 0, 0
+	for _, s := range srcs {
+		n += len(s)
+		longest = max(longest, len(s))
+	}
+	res := make([]T, 0, n)
+	for i := 0; i < longest; i++ {
+		for _, s := range srcs {
+			if i < len(s) {
+				res = append(res, s[i])
+			}
+		}
+	}
+	return res
+}
+
+// Pair 是 Zip 返回的一对元素
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip 将 a 和 b 中相同下标的元素组合为 Pair，长度以较短的切片为准
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+	res := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		res[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return res
+}
+
+// Unzip 是 Zip 的逆操作，将 Pair 拆分为两个切片
+func Unzip[A, B any](src []Pair[A, B]) ([]A, []B) {
+	as, bs := make([]A, len(src)), make([]B, len(src))
+	for i, p := range src {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
@@ -0,0 +1,109 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     []int
+		size    int
+		want    [][]int
+		wantErr bool
+	}{
+		{name: "整除", src: []int{1, 2, 3, 4}, size: 2, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "不整除", src: []int{1, 2, 3, 4, 5}, size: 2, want: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "size 大于长度", src: []int{1, 2}, size: 5, want: [][]int{{1, 2}}},
+		{name: "空切片", src: nil, size: 3, want: [][]int{}},
+		{name: "size 为 0", src: []int{1}, size: 0, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Chunk(tc.src, tc.size)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// 向分组中 append 不会覆盖原切片
+func TestChunk_Append(t *testing.T) {
+	src := []int{1, 2, 3, 4}
+	chunks, err := Chunk(src, 2)
+	require.NoError(t, err)
+	_ = append(chunks[0], 100)
+	assert.Equal(t, []int{1, 2, 3, 4}, src)
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     []int
+		size    int
+		step    int
+		want    [][]int
+		wantErr bool
+	}{
+		{name: "滑动", src: []int{1, 2, 3, 4}, size: 2, step: 1, want: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{name: "步长大于窗口", src: []int{1, 2, 3, 4, 5, 6}, size: 2, step: 3, want: [][]int{{1, 2}, {4, 5}}},
+		{name: "丢弃不完整的窗口", src: []int{1, 2, 3, 4, 5}, size: 2, step: 2, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "长度不足", src: []int{1}, size: 2, step: 1, want: [][]int{}},
+		{name: "size 为 0", src: []int{1}, size: 0, step: 1, wantErr: true},
+		{name: "step 为负数", src: []int{1}, size: 1, step: -1, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Window(tc.src, tc.size, tc.step)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3, 4}, Flatten([][]int{{1, 2}, {}, nil, {3, 4}}))
+	assert.Equal(t, []int{}, Flatten[int](nil))
+}
+
+func TestFlatMap(t *testing.T) {
+	got := FlatMap([]int{1, 2, 3}, func(i int) []int {
+		res := make([]int, i)
+		for j := range res {
+			res[j] = i
+		}
+		return res
+	})
+	assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, got)
+	assert.Equal(t, []string{}, FlatMap(nil, func(i int) []string { return nil }))
+}
+
+func TestInterleave(t *testing.T) {
+	assert.Equal(t, []int{1, 4, 5, 2, 6, 3}, Interleave([]int{1, 2, 3}, []int{4}, []int{5, 6}))
+	assert.Equal(t, []int{1, 2}, Interleave([]int{1, 2}))
+	assert.Equal(t, []int{}, Interleave[int]())
+}
+
+func TestZip(t *testing.T) {
+	pairs := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, pairs)
+	as, bs := Unzip(pairs)
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []string{"a", "b"}, bs)
+
+	assert.Equal(t, []Pair[int, int]{}, Zip[int, int](nil, []int{1}))
+	emptyA, emptyB := Unzip[int, int](nil)
+	assert.Equal(t, []int{}, emptyA)
+	assert.Equal(t, []int{}, emptyB)
+}
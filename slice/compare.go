@@ -0,0 +1,17 @@
+package slice
+
+import "slices"
+
+// EqualFunc 判断 a 和 b 的长度相同，并且对应下标的元素都满足 eq
+// nil 与长度为 0 的切片视为相等
+func EqualFunc[T any](a, b []T, eq func(x, y T) bool) bool {
+	return slices.EqualFunc(a, b, eq)
+}
+
+// Clone 返回 src 的副本，修改副本不会影响 src
+// 与 AsSlice 的约定一致，src 为 nil 时返回长度和容量都为 0 的切片，而不是 nil
+func Clone[T any](src []T) []T {
+	res := make([]T, len(src))
+	copy(res, src)
+	return res
+}
@@ -0,0 +1,47 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualFunc(t *testing.T) {
+	eq := func(x, y int) bool { return x == y }
+	tests := []struct {
+		name string
+		a    []int
+		b    []int
+		want bool
+	}{
+		{name: "相等", a: []int{1, 2, 3}, b: []int{1, 2, 3}, want: true},
+		{name: "nil 与空切片", a: nil, b: []int{}, want: true},
+		{name: "长度不同", a: []int{1, 2}, b: []int{1, 2, 3}, want: false},
+		{name: "元素不同", a: []int{1, 2, 3}, b: []int{1, 4, 3}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EqualFunc(tt.a, tt.b, eq))
+		})
+	}
+
+	// 不要求元素类型可比较
+	type item struct {
+		id   int
+		tags []string
+	}
+	byID := func(x, y item) bool { return x.id == y.id }
+	assert.True(t, EqualFunc([]item{{id: 1}}, []item{{id: 1, tags: []string{"a"}}}, byID))
+}
+
+func TestClone(t *testing.T) {
+	src := []int{1, 2, 3}
+	res := Clone(src)
+	assert.Equal(t, src, res)
+	res[0] = 10
+	assert.Equal(t, []int{1, 2, 3}, src)
+
+	res = Clone[int](nil)
+	assert.NotNil(t, res)
+	assert.Empty(t, res)
+}
@@ -0,0 +1,31 @@
+package slice
+
+import "cmp"
+
+// Signed 有符号整数
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned 无符号整数
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer 整数
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float 浮点数
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number 可以进行四则运算的数字类型
+type Number interface {
+	Integer | Float
+}
+
+// Ordered 支持 < <= >= > 的类型，与 cmp.Ordered 相同
+type Ordered = cmp.Ordered
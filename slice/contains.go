@@ -0,0 +1,58 @@
+package slice
+
+// Contains 判断 src 中是否存在 dst
+func Contains[T comparable](src []T, dst T) bool {
+	return Index(src, dst) >= 0
+}
+
+// ContainsFunc 判断 src 中是否存在与 dst 相等的元素，equal 的参数依次为 src 中的元素和 dst
+func ContainsFunc[T any](src []T, dst T, equal func(src, dst T) bool) bool {
+	for _, v := range src {
+		if equal(v, dst) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll 判断 src 中是否存在 dst 中的所有元素，dst 为空时返回 true
+func ContainsAll[T comparable](src, dst []T) bool {
+	set := toSet(src)
+	for _, v := range dst {
+		if _, ok := set[v]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAllFunc 判断 src 中是否存在与 dst 中每一个元素相等的元素，dst 为空时返回 true
+func ContainsAllFunc[T any](src, dst []T, equal func(src, dst T) bool) bool {
+	for _, v := range dst {
+		if !ContainsFunc(src, v, equal) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny 判断 src 中是否存在 dst 中的任意一个元素，dst 为空时返回 false
+func ContainsAny[T comparable](src, dst []T) bool {
+	set := toSet(src)
+	for _, v := range dst {
+		if _, ok := set[v]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAnyFunc 判断 src 中是否存在与 dst 中任意一个元素相等的元素，dst 为空时返回 false
+func ContainsAnyFunc[T any](src, dst []T, equal func(src, dst T) bool) bool {
+	for _, v := range dst {
+		if ContainsFunc(src, v, equal) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package slice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		dst  int
+		want bool
+	}{
+		{name: "存在", src: []int{1, 2, 3}, dst: 2, want: true},
+		{name: "不存在", src: []int{1, 2, 3}, dst: 4, want: false},
+		{name: "空切片", src: nil, dst: 1, want: false},
+	}
+	equal := func(src, dst int) bool { return src == dst }
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Contains(tc.src, tc.dst))
+			assert.Equal(t, tc.want, ContainsFunc(tc.src, tc.dst, equal))
+		})
+	}
+}
+
+func TestContainsAllAny(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     []string
+		dst     []string
+		wantAll bool
+		wantAny bool
+	}{
+		{name: "全部存在", src: []string{"a", "b", "c"}, dst: []string{"c", "a", "a"}, wantAll: true, wantAny: true},
+		{name: "部分存在", src: []string{"a", "b"}, dst: []string{"b", "d"}, wantAll: false, wantAny: true},
+		{name: "都不存在", src: []string{"a", "b"}, dst: []string{"c", "d"}, wantAll: false, wantAny: false},
+		{name: "dst 为空", src: []string{"a"}, dst: []string{}, wantAll: true, wantAny: false},
+		{name: "src 为空", src: nil, dst: []string{"a"}, wantAll: false, wantAny: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantAll, ContainsAll(tc.src, tc.dst))
+			assert.Equal(t, tc.wantAny, ContainsAny(tc.src, tc.dst))
+		})
+	}
+}
+
+func TestContainsAllAnyFunc(t *testing.T) {
+	// 不区分大小写
+	equal := func(src, dst string) bool { return strings.EqualFold(src, dst) }
+	src := []string{"Go", "Rust"}
+	assert.True(t, ContainsFunc(src, "go", equal))
+	assert.True(t, ContainsAllFunc(src, []string{"GO", "rust"}, equal))
+	assert.False(t, ContainsAllFunc(src, []string{"go", "java"}, equal))
+	assert.True(t, ContainsAllFunc(src, nil, equal))
+	assert.True(t, ContainsAnyFunc(src, []string{"java", "RUST"}, equal))
+	assert.False(t, ContainsAnyFunc(src, []string{"java"}, equal))
+	assert.False(t, ContainsAnyFunc(src, nil, equal))
+}
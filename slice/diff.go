@@ -0,0 +1,189 @@
+package slice
+
+// EditKind 表示 Diff 结果中一次编辑的类型
+type EditKind int
+
+const (
+	// EditInsert 在新切片中插入了元素
+	EditInsert EditKind = iota
+	// EditDelete 删除了旧切片中的元素
+	EditDelete
+	// EditMove 旧切片中的元素被移动到了新切片中的其他位置
+	EditMove
+)
+
+func (k EditKind) String() string {
+	switch k {
+	case EditInsert:
+		return "insert"
+	case EditDelete:
+		return "delete"
+	case EditMove:
+		return "move"
+	default:
+		return "unknown"
+	}
+}
+
+// Edit 是 Diff 结果中的一次编辑
+// OldIndex 是元素在旧切片中的下标，插入时为 -1；
+// NewIndex 是元素在新切片中的下标，删除时为 -1
+type Edit[T any] struct {
+	Kind     EditKind
+	OldIndex int
+	NewIndex int
+	Value    T
+}
+
+// Diff 计算从 old 变为 cur 所需要的编辑，不会修改 old 和 cur
+// 先使用 Myers 差分算法求出两者的最长公共子序列（LCS），公共子序列中的元素保持不动；
+// 剩下的元素中，旧切片与新切片里相等的元素配对为移动，其余的旧元素为删除，新元素为插入。
+// 返回的结果中先按照 OldIndex 递增的顺序列出删除和移动，再按照 NewIndex 递增的顺序列出插入
+//
+// 设 n、m 分别为 old 和 cur 的长度，d 为最少需要删除和插入的元素个数之和：
+// 求 LCS 的时间复杂度为 O((n+m)*d)，额外空间为 O(n+m)，两者相近时接近线性；
+// 配对移动时，每个被删除的元素都要与所有尚未配对的被插入元素逐个比较，最坏情况下调用 O(d²) 次 eq
+func Diff[T any](old, cur []T, eq func(a, b T) bool) []Edit[T] {
+	maxD := (len(old) + len(cur) + 1) / 2
+	d := &differ[T]{
+		a:  old,
+		b:  cur,
+		eq: eq,
+		vf: make([]int, 2*maxD+2),
+		vb: make([]int, 2*maxD+2),
+	}
+	d.diff(0, len(old), 0, len(cur))
+	deleted, inserted := d.deleted, d.inserted
+
+	edits := make([]Edit[T], 0, len(deleted)+len(inserted))
+	matched := make([]bool, len(inserted))
+	for _, di := range deleted {
+		edit := Edit[T]{Kind: EditDelete, OldIndex: di, NewIndex: -1, Value: old[di]}
+		for k, ij := range inserted {
+			if !matched[k] && eq(old[di], cur[ij]) {
+				matched[k] = true
+				edit.Kind, edit.NewIndex = EditMove, ij
+				break
+			}
+		}
+		edits = append(edits, edit)
+	}
+	for k, ij := range inserted {
+		if !matched[k] {
+			edits = append(edits, Edit[T]{Kind: EditInsert, OldIndex: -1, NewIndex: ij, Value: cur[ij]})
+		}
+	}
+	return edits
+}
+
+// differ 使用 Myers 的线性空间算法，找出 a 与 b 中不属于最长公共子序列的元素
+type differ[T any] struct {
+	a, b []T
+	eq   func(a, b T) bool
+	// vf、vb 分别记录正向、反向搜索时每条对角线上能够到达的最远的 x，所有的 split 共用
+	vf, vb []int
+	// deleted、inserted 分别是 a、b 中不属于公共子序列的下标，按照递增的顺序排列
+	deleted, inserted []int
+}
+
+// diff 处理 a[aLo:aHi] 与 b[bLo:bHi]
+func (d *differ[T]) diff(aLo, aHi, bLo, bHi int) {
+	// 公共前缀和后缀一定属于最长公共子序列，先去掉以减少计算量
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aLo], d.b[bLo]) {
+		aLo++
+		bLo++
+	}
+	for aLo < aHi && bLo < bHi && d.eq(d.a[aHi-1], d.b[bHi-1]) {
+		aHi--
+		bHi--
+	}
+	if aLo < aHi && bLo < bHi {
+		if x, y, ok := d.split(aLo, aHi, bLo, bHi); ok {
+			d.diff(aLo, x, bLo, y)
+			d.diff(x, aHi, y, bHi)
+			return
+		}
+	}
+	// 其中一方为空，或者两者没有任何相等的元素
+	for i := aLo; i < aHi; i++ {
+		d.deleted = append(d.deleted, i)
+	}
+	for j := bLo; j < bHi; j++ {
+		d.inserted = append(d.inserted, j)
+	}
+}
+
+// split 从两端同时搜索编辑路径，在两者重叠的位置 (x, y) 把问题一分为二，
+// 两部分各自的编辑距离之和等于原问题的编辑距离，因此可以分别递归求解
+// 两者没有任何相等的元素时返回 false
+func (d *differ[T]) split(aLo, aHi, bLo, bHi int) (int, int, bool) {
+	a, b := d.a[aLo:aHi], d.b[bLo:bHi]
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	// 对角线 k = x - y 的取值范围是 [-maxD, maxD]，-1 表示尚未到达
+	vf, vb := d.vf[:2*maxD+2], d.vb[:2*maxD+2]
+	for i := range vf {
+		vf[i], vb[i] = -1, -1
+	}
+	offset := maxD
+	vf[offset+1], vb[offset+1] = 0, 0
+	// 反向搜索在 (n-x, m-y) 坐标系中进行，正向的对角线 k 对应反向的对角线 delta-k
+	delta := n - m
+	// 编辑距离的奇偶性与 delta 相同，奇数时在正向搜索中检查重叠，偶数时在反向搜索中检查
+	front := delta%2 != 0
+	// 越过边界的对角线不再需要继续搜索
+	var fStart, fEnd, bStart, bEnd int
+	for step := 0; step < maxD; step++ {
+		for k := -step + fStart; k <= step-fEnd; k += 2 {
+			var x int
+			if k == -step || (k != step && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1]
+			} else {
+				x = vf[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.eq(a[x], b[y]) {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case front:
+				c := offset + delta - k
+				if c >= 0 && c < len(vb) && vb[c] != -1 && x >= n-vb[c] {
+					return aLo + x, bLo + y, true
+				}
+			}
+		}
+		for c := -step + bStart; c <= step-bEnd; c += 2 {
+			var x int
+			if c == -step || (c != step && vb[offset+c-1] < vb[offset+c+1]) {
+				x = vb[offset+c+1]
+			} else {
+				x = vb[offset+c-1] + 1
+			}
+			y := x - c
+			for x < n && y < m && d.eq(a[n-1-x], b[m-1-y]) {
+				x++
+				y++
+			}
+			vb[offset+c] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !front:
+				k := delta - c
+				if f := offset + k; f >= 0 && f < len(vf) && vf[f] != -1 && vf[f] >= n-x {
+					return aLo + vf[f], bLo + vf[f] - k, true
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
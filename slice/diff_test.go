@@ -0,0 +1,165 @@
+package slice
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	eq := func(a, b string) bool { return a == b }
+	tests := []struct {
+		name string
+		old  []string
+		cur  []string
+		want []Edit[string]
+	}{
+		{
+			name: "完全相同",
+			old:  []string{"a", "b"},
+			cur:  []string{"a", "b"},
+			want: []Edit[string]{},
+		},
+		{
+			name: "都为空",
+			old:  []string{},
+			cur:  []string{},
+			want: []Edit[string]{},
+		},
+		{
+			name: "插入",
+			old:  []string{"a", "c"},
+			cur:  []string{"a", "b", "c", "d"},
+			want: []Edit[string]{
+				{Kind: EditInsert, OldIndex: -1, NewIndex: 1, Value: "b"},
+				{Kind: EditInsert, OldIndex: -1, NewIndex: 3, Value: "d"},
+			},
+		},
+		{
+			name: "删除",
+			old:  []string{"a", "b", "c"},
+			cur:  []string{"b"},
+			want: []Edit[string]{
+				{Kind: EditDelete, OldIndex: 0, NewIndex: -1, Value: "a"},
+				{Kind: EditDelete, OldIndex: 2, NewIndex: -1, Value: "c"},
+			},
+		},
+		{
+			name: "移动",
+			old:  []string{"a", "b", "c", "d"},
+			cur:  []string{"b", "c", "d", "a"},
+			want: []Edit[string]{
+				{Kind: EditMove, OldIndex: 0, NewIndex: 3, Value: "a"},
+			},
+		},
+		{
+			name: "混合",
+			old:  []string{"a", "b", "c", "d"},
+			cur:  []string{"d", "b", "e", "c"},
+			want: []Edit[string]{
+				{Kind: EditDelete, OldIndex: 0, NewIndex: -1, Value: "a"},
+				{Kind: EditMove, OldIndex: 3, NewIndex: 0, Value: "d"},
+				{Kind: EditInsert, OldIndex: -1, NewIndex: 2, Value: "e"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.old, tt.cur, eq)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.cur, apply(tt.old, len(tt.cur), got))
+		})
+	}
+}
+
+func TestDiff_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	eq := func(a, b int) bool { return a == b }
+	randSlice := func(maxLen, alphabet int) []int {
+		res := make([]int, r.Intn(maxLen))
+		for i := range res {
+			res[i] = r.Intn(alphabet)
+		}
+		return res
+	}
+	for i := 0; i < 2000; i++ {
+		maxLen, alphabet := 12, 6
+		if i%4 == 0 {
+			maxLen, alphabet = 80, 20
+		}
+		old, cur := randSlice(maxLen, alphabet), randSlice(maxLen, alphabet)
+		edits := Diff(old, cur, eq)
+		assert.Equal(t, cur, apply(old, len(cur), edits), "old = %v, cur = %v", old, cur)
+		// 保持不动的元素恰好是一个最长公共子序列
+		kept := len(old)
+		for _, e := range edits {
+			if e.OldIndex >= 0 {
+				kept--
+			}
+		}
+		assert.Equal(t, lcsLen(old, cur), kept, "old = %v, cur = %v", old, cur)
+	}
+}
+
+// 长列表中只有中间部分发生变化，不会分配 O(n*m) 的内存
+func TestDiff_Large(t *testing.T) {
+	const n = 10000
+	old, cur := make([]int, n), make([]int, n)
+	for i := range old {
+		old[i], cur[i] = i, i
+	}
+	for i := n/2 - 50; i < n/2+50; i++ {
+		cur[i] = -i
+	}
+	// 把开头的元素移动到末尾，避免只靠公共前缀和后缀完成
+	cur = append(cur[1:], old[0])
+	edits := Diff(old, cur, func(a, b int) bool { return a == b })
+	assert.Equal(t, cur, apply(old, len(cur), edits))
+	assert.Len(t, edits, 201)
+}
+
+// lcsLen 使用动态规划求最长公共子序列的长度，作为对照
+func lcsLen(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else {
+				dp[i][j] = max(dp[i+1][j], dp[i][j+1])
+			}
+		}
+	}
+	return dp[0][0]
+}
+
+// apply 根据 edits 从 old 构造新切片：保留未被删除或移动的元素作为骨架，
+// 再把移动和插入的元素放到 NewIndex 处，用于验证 Diff 的结果是完整的
+func apply[T any](old []T, newLen int, edits []Edit[T]) []T {
+	res := make([]T, newLen)
+	filled := make([]bool, newLen)
+	removed := make([]bool, len(old))
+	for _, e := range edits {
+		if e.OldIndex >= 0 {
+			removed[e.OldIndex] = true
+		}
+		if e.NewIndex >= 0 {
+			res[e.NewIndex], filled[e.NewIndex] = e.Value, true
+		}
+	}
+	k := 0
+	for i, v := range old {
+		if removed[i] {
+			continue
+		}
+		for filled[k] {
+			k++
+		}
+		res[k], filled[k] = v, true
+	}
+	return res
+}
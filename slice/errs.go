@@ -0,0 +1,28 @@
+package slice
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmptySlice 表示需要至少一个元素的操作收到了空切片
+	ErrEmptySlice = errors.New("mkit: 切片为空")
+	// ErrOverflow 表示整数运算的结果超出了类型的表示范围
+	ErrOverflow = errors.New("mkit: 整数溢出")
+)
+
+// IndexError 表示处理 src 中下标为 Index 的元素时返回了错误 Err
+// 可以通过 errors.As 取出下标，通过 errors.Is 判断原始错误
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("mkit: 处理下标 %d 的元素失败: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
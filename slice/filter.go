@@ -1,43 +1,42 @@
 package slice
 
-func FilterFunc[T any](src []T, filter func(T) bool) ([]T, error) {
+// FilterFunc 返回 src 中满足 filter 的元素
+// 原切片不会被修改
+func FilterFunc[T any](src []T, filter func(T) bool) []T {
+	return FilterWithIndex(src, func(_ int, t T) bool { return filter(t) })
+}
+
+// FilterWithIndex 返回 src 中满足 filter 的元素，filter 接收索引和值
+// 原切片不会被修改
+func FilterWithIndex[T any](src []T, filter func(int, T) bool) []T {
+	// 预分配容量，避免多次扩容
 	dst := make([]T, 0, len(src))
-	for _, v := range src {
-		if filter(v) {
+	for i, v := range src {
+		if filter(i, v) {
 			dst = append(dst, v)
 		}
 	}
-	return dst, nil
+	return dst
 }
 
-// func Filter[T any](src []T, predicate func(T) bool) []T {
-// 	if len(src) == 0 {
-// 		return make([]T, 0)
-// 	}
-
-// 	// 预分配容量，避免多次扩容
-// 	result := make([]T, 0, len(src))
-// 	for _, v := range src {
-// 		if predicate(v) {
-// 			result = append(result, v)
-// 		}
-// 	}
-// 	return result
-// }
-
-// // FilterWithIndex 根据条件函数过滤切片中的元素，条件函数接收索引和值
-// // 原切片不会被修改
-// func FilterWithIndex[T any](src []T, predicate func(int, T) bool) []T {
-// 	if len(src) == 0 {
-// 		return make([]T, 0)
-// 	}
+// FilterErr 与 FilterFunc 相同，但是 filter 可以返回错误
+// 遇到第一个错误时立即停止，返回 nil 和包含该元素下标的 *IndexError
+func FilterErr[T any](src []T, filter func(T) (bool, error)) ([]T, error) {
+	return FilterWithIndexErr(src, func(_ int, t T) (bool, error) { return filter(t) })
+}
 
-// 	// 预分配容量，避免多次扩容
-// 	result := make([]T, 0, len(src))
-// 	for i, v := range src {
-// 		if predicate(i, v) {
-// 			result = append(result, v)
-// 		}
-// 	}
-// 	return result
-// }
+// FilterWithIndexErr 与 FilterWithIndex 相同，但是 filter 可以返回错误
+// 遇到第一个错误时立即停止，返回 nil 和包含该元素下标的 *IndexError
+func FilterWithIndexErr[T any](src []T, filter func(int, T) (bool, error)) ([]T, error) {
+	dst := make([]T, 0, len(src))
+	for i, v := range src {
+		ok, err := filter(i, v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			dst = append(dst, v)
+		}
+	}
+	return dst, nil
+}
@@ -1,19 +1,20 @@
 package slice
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestFilterFunc 针对 filterFunc 泛型函数的单元测试，覆盖不同类型和过滤条件
 func TestFilterFunc(t *testing.T) {
 	type testCase[T any] struct {
-		name    string
-		src     []T
-		filter  func(T) bool
-		want    []T
-		wantErr bool
+		name   string
+		src    []T
+		filter func(T) bool
+		want   []T
 	}
 
 	// int 类型测试
@@ -46,13 +47,8 @@ func TestFilterFunc(t *testing.T) {
 
 	for _, tc := range testsInt {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := FilterFunc(tc.src, tc.filter)
-			if tc.wantErr {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tc.want, got)
-			}
+			got := FilterFunc(tc.src, tc.filter)
+			assert.Equal(t, tc.want, got)
 		})
 	}
 
@@ -80,13 +76,72 @@ func TestFilterFunc(t *testing.T) {
 
 	for _, tc := range testsString {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := FilterFunc(tc.src, tc.filter)
-			if tc.wantErr {
-				assert.Error(t, err)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tc.want, got)
+			got := FilterFunc(tc.src, tc.filter)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFilterWithIndex(t *testing.T) {
+	got := FilterWithIndex([]string{"a", "b", "c", "d"}, func(i int, s string) bool { return i%2 == 1 })
+	assert.Equal(t, []string{"b", "d"}, got)
+	assert.Equal(t, []string{}, FilterWithIndex(nil, func(i int, s string) bool { return true }))
+}
+
+func TestFilterErr(t *testing.T) {
+	errBoom := errors.New("boom")
+	tests := []struct {
+		name      string
+		src       []int
+		want      []int
+		wantIndex int
+	}{
+		{name: "没有错误", src: []int{1, 2, 3, 4}, want: []int{2, 4}},
+		{name: "空切片", src: []int{}, want: []int{}},
+		{name: "负数返回错误", src: []int{1, 2, -3, -4}, wantIndex: 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int
+			got, err := FilterErr(tc.src, func(i int) (bool, error) {
+				calls++
+				if i < 0 {
+					return false, errBoom
+				}
+				return i%2 == 0, nil
+			})
+			if tc.want == nil {
+				assert.Nil(t, got)
+				assert.ErrorIs(t, err, errBoom)
+				var idxErr *IndexError
+				require.ErrorAs(t, err, &idxErr)
+				assert.Equal(t, tc.wantIndex, idxErr.Index)
+				// 遇到第一个错误时立即停止
+				assert.Equal(t, tc.wantIndex+1, calls)
+				return
 			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
 		})
 	}
 }
+
+func TestFilterWithIndexErr(t *testing.T) {
+	errBoom := errors.New("boom")
+	got, err := FilterWithIndexErr([]string{"a", "b", "c"}, func(i int, s string) (bool, error) {
+		return i != 1, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "c"}, got)
+
+	_, err = FilterWithIndexErr([]string{"a", "b", "c"}, func(i int, s string) (bool, error) {
+		if s == "c" {
+			return false, errBoom
+		}
+		return true, nil
+	})
+	var idxErr *IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 2, idxErr.Index)
+	assert.ErrorIs(t, err, errBoom)
+}
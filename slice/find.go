@@ -0,0 +1,67 @@
+package slice
+
+// Find 返回 src 中第一个满足 match 的元素，不存在时返回 false
+func Find[T any](src []T, match func(t T) bool) (T, bool) {
+	if i := FindIndex(src, match); i >= 0 {
+		return src[i], true
+	}
+	var zeroValue T
+	return zeroValue, false
+}
+
+// FindIndex 返回 src 中第一个满足 match 的元素的下标，不存在时返回 -1
+func FindIndex[T any](src []T, match func(t T) bool) int {
+	for i, v := range src {
+		if match(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLast 返回 src 中最后一个满足 match 的元素，不存在时返回 false
+func FindLast[T any](src []T, match func(t T) bool) (T, bool) {
+	if i := FindLastIndex(src, match); i >= 0 {
+		return src[i], true
+	}
+	var zeroValue T
+	return zeroValue, false
+}
+
+// FindLastIndex 返回 src 中最后一个满足 match 的元素的下标，不存在时返回 -1
+func FindLastIndex[T any](src []T, match func(t T) bool) int {
+	for i := len(src) - 1; i >= 0; i-- {
+		if match(src[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Index 返回 dst 在 src 中第一次出现的下标，不存在时返回 -1
+func Index[T comparable](src []T, dst T) int {
+	return FindIndex(src, func(t T) bool { return t == dst })
+}
+
+// LastIndex 返回 dst 在 src 中最后一次出现的下标，不存在时返回 -1
+func LastIndex[T comparable](src []T, dst T) int {
+	return FindLastIndex(src, func(t T) bool { return t == dst })
+}
+
+// IndexAll 按照从小到大的顺序返回 dst 在 src 中出现的所有下标
+// 不存在时返回一个长度为 0 的切片
+func IndexAll[T comparable](src []T, dst T) []int {
+	return IndexAllFunc(src, func(t T) bool { return t == dst })
+}
+
+// IndexAllFunc 按照从小到大的顺序返回 src 中所有满足 match 的元素的下标
+// 不存在时返回一个长度为 0 的切片
+func IndexAllFunc[T any](src []T, match func(t T) bool) []int {
+	indexes := []int{}
+	for i, v := range src {
+		if match(v) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,89 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {2, "b"}, {3, "a"}}
+	tests := []struct {
+		name          string
+		match         func(u user) bool
+		wantFirst     user
+		wantLast      user
+		wantFound     bool
+		wantIndex     int
+		wantLastIndex int
+		wantAll       []int
+	}{
+		{
+			name:          "多个匹配",
+			match:         func(u user) bool { return u.name == "a" },
+			wantFirst:     user{1, "a"},
+			wantLast:      user{3, "a"},
+			wantFound:     true,
+			wantIndex:     0,
+			wantLastIndex: 2,
+			wantAll:       []int{0, 2},
+		},
+		{
+			name:          "一个匹配",
+			match:         func(u user) bool { return u.id == 2 },
+			wantFirst:     user{2, "b"},
+			wantLast:      user{2, "b"},
+			wantFound:     true,
+			wantIndex:     1,
+			wantLastIndex: 1,
+			wantAll:       []int{1},
+		},
+		{
+			name:          "没有匹配",
+			match:         func(u user) bool { return u.id > 3 },
+			wantIndex:     -1,
+			wantLastIndex: -1,
+			wantAll:       []int{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			first, ok := Find(users, tc.match)
+			assert.Equal(t, tc.wantFound, ok)
+			assert.Equal(t, tc.wantFirst, first)
+			last, ok := FindLast(users, tc.match)
+			assert.Equal(t, tc.wantFound, ok)
+			assert.Equal(t, tc.wantLast, last)
+			assert.Equal(t, tc.wantIndex, FindIndex(users, tc.match))
+			assert.Equal(t, tc.wantLastIndex, FindLastIndex(users, tc.match))
+			assert.Equal(t, tc.wantAll, IndexAllFunc(users, tc.match))
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           []int
+		dst           int
+		wantIndex     int
+		wantLastIndex int
+		wantAll       []int
+	}{
+		{name: "多次出现", src: []int{1, 2, 1, 3, 1}, dst: 1, wantIndex: 0, wantLastIndex: 4, wantAll: []int{0, 2, 4}},
+		{name: "出现一次", src: []int{1, 2, 3}, dst: 3, wantIndex: 2, wantLastIndex: 2, wantAll: []int{2}},
+		{name: "不存在", src: []int{1, 2, 3}, dst: 4, wantIndex: -1, wantLastIndex: -1, wantAll: []int{}},
+		{name: "空切片", src: nil, dst: 1, wantIndex: -1, wantLastIndex: -1, wantAll: []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantIndex, Index(tc.src, tc.dst))
+			assert.Equal(t, tc.wantLastIndex, LastIndex(tc.src, tc.dst))
+			assert.Equal(t, tc.wantAll, IndexAll(tc.src, tc.dst))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package slice
+
+// GroupBy 按照 key 将 src 中的元素分组，每组中的元素保持在 src 中的顺序
+func GroupBy[T any, K comparable](src []T, key func(t T) K) map[K][]T {
+	res := make(map[K][]T)
+	for _, v := range src {
+		k := key(v)
+		res[k] = append(res[k], v)
+	}
+	return res
+}
+
+// Group 是 GroupByOrdered 返回的一个分组
+type Group[K comparable, T any] struct {
+	Key    K
+	Values []T
+}
+
+// GroupByOrdered 与 GroupBy 相同，但是分组按照 key 第一次出现的顺序排列
+func GroupByOrdered[T any, K comparable](src []T, key func(t T) K) []Group[K, T] {
+	res := []Group[K, T]{}
+	pos := make(map[K]int)
+	for _, v := range src {
+		k := key(v)
+		i, ok := pos[k]
+		if !ok {
+			i = len(res)
+			pos[k] = i
+			res = append(res, Group[K, T]{Key: k})
+		}
+		res[i].Values = append(res[i].Values, v)
+	}
+	return res
+}
+
+// Partition 将 src 分为满足 match 和不满足 match 的两部分，两部分都保持在 src 中的顺序
+func Partition[T any](src []T, match func(t T) bool) (yes, no []T) {
+	yes, no = []T{}, []T{}
+	for _, v := range src {
+		if match(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// KeyBy 使用 key 作为键将 src 转化为 map，key 相同时保留最后一个元素
+func KeyBy[T any, K comparable](src []T, key func(t T) K) map[K]T {
+	res := make(map[K]T, len(src))
+	for _, v := range src {
+		res[key(v)] = v
+	}
+	return res
+}
+
+// CountBy 按照 key 统计每组的元素个数
+func CountBy[T any, K comparable](src []T, key func(t T) K) map[K]int {
+	res := make(map[K]int)
+	for _, v := range src {
+		res[key(v)]++
+	}
+	return res
+}
+
+// ToMap 使用 kv 返回的键值对将 src 转化为 map，键相同时保留最后一个值
+func ToMap[T any, K comparable, V any](src []T, kv func(t T) (K, V)) map[K]V {
+	res := make(map[K]V, len(src))
+	for _, v := range src {
+		k, val := kv(v)
+		res[k] = val
+	}
+	return res
+}
@@ -0,0 +1,75 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type groupUser struct {
+	id   int
+	dept string
+}
+
+var groupUsers = []groupUser{{1, "b"}, {2, "a"}, {3, "b"}, {4, "c"}, {5, "a"}}
+
+func groupUserDept(u groupUser) string { return u.dept }
+
+func TestGroupBy(t *testing.T) {
+	assert.Equal(t, map[string][]groupUser{
+		"a": {{2, "a"}, {5, "a"}},
+		"b": {{1, "b"}, {3, "b"}},
+		"c": {{4, "c"}},
+	}, GroupBy(groupUsers, groupUserDept))
+	assert.Equal(t, map[string][]groupUser{}, GroupBy(nil, groupUserDept))
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	assert.Equal(t, []Group[string, groupUser]{
+		{Key: "b", Values: []groupUser{{1, "b"}, {3, "b"}}},
+		{Key: "a", Values: []groupUser{{2, "a"}, {5, "a"}}},
+		{Key: "c", Values: []groupUser{{4, "c"}}},
+	}, GroupByOrdered(groupUsers, groupUserDept))
+	assert.Equal(t, []Group[string, groupUser]{}, GroupByOrdered(nil, groupUserDept))
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     []int
+		wantYes []int
+		wantNo  []int
+	}{
+		{name: "两部分都有", src: []int{1, 2, 3, 4, 5}, wantYes: []int{2, 4}, wantNo: []int{1, 3, 5}},
+		{name: "全部满足", src: []int{2, 4}, wantYes: []int{2, 4}, wantNo: []int{}},
+		{name: "空切片", src: nil, wantYes: []int{}, wantNo: []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			yes, no := Partition(tc.src, func(i int) bool { return i%2 == 0 })
+			assert.Equal(t, tc.wantYes, yes)
+			assert.Equal(t, tc.wantNo, no)
+		})
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	assert.Equal(t, map[string]groupUser{
+		"a": {5, "a"},
+		"b": {3, "b"},
+		"c": {4, "c"},
+	}, KeyBy(groupUsers, groupUserDept))
+}
+
+func TestCountBy(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 2, "b": 2, "c": 1}, CountBy(groupUsers, groupUserDept))
+	assert.Equal(t, map[string]int{}, CountBy(nil, groupUserDept))
+}
+
+func TestToMap(t *testing.T) {
+	got := ToMap(groupUsers, func(u groupUser) (int, string) { return u.id, u.dept })
+	assert.Equal(t, map[int]string{1: "b", 2: "a", 3: "b", 4: "c", 5: "a"}, got)
+	// 键相同时保留最后一个值
+	got2 := ToMap(groupUsers, func(u groupUser) (string, int) { return u.dept, u.id })
+	assert.Equal(t, map[string]int{"a": 5, "b": 3, "c": 4}, got2)
+}
@@ -41,3 +41,23 @@ func MapWithIndex[T, U any](src []T, f func(int, T) U) []U {
 	}
 	return result
 }
+
+// MapErr 与 Map 相同，但是 f 可以返回错误
+// 遇到第一个错误时立即停止，返回 nil 和包含该元素下标的 *IndexError
+func MapErr[T, U any](src []T, f func(T) (U, error)) ([]U, error) {
+	return MapWithIndexErr(src, func(_ int, t T) (U, error) { return f(t) })
+}
+
+// MapWithIndexErr 与 MapWithIndex 相同，但是 f 可以返回错误
+// 遇到第一个错误时立即停止，返回 nil 和包含该元素下标的 *IndexError
+func MapWithIndexErr[T, U any](src []T, f func(int, T) (U, error)) ([]U, error) {
+	result := make([]U, len(src))
+	for i, v := range src {
+		u, err := f(i, v)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		result[i] = u
+	}
+	return result, nil
+}
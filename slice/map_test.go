@@ -1,8 +1,13 @@
 package slice
 
 import (
+	"errors"
 	"reflect"
+	"strconv"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMap(t *testing.T) {
@@ -109,3 +114,44 @@ func TestMap_OriginalUnchanged(t *testing.T) {
 		t.Errorf("原始切片被修改了，期望 %v，实际 %v", original, input)
 	}
 }
+
+func TestMapErr(t *testing.T) {
+	got, err := MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, got)
+
+	got, err = MapErr([]string{}, strconv.Atoi)
+	require.NoError(t, err)
+	assert.Equal(t, []int{}, got)
+
+	var calls int
+	got, err = MapErr([]string{"1", "x", "y"}, func(s string) (int, error) {
+		calls++
+		return strconv.Atoi(s)
+	})
+	assert.Nil(t, got)
+	var idxErr *IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 1, idxErr.Index)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Equal(t, 2, calls)
+}
+
+func TestMapWithIndexErr(t *testing.T) {
+	errBoom := errors.New("boom")
+	got, err := MapWithIndexErr([]int{10, 20}, func(i, x int) (int, error) { return i + x, nil })
+	require.NoError(t, err)
+	assert.Equal(t, []int{10, 21}, got)
+
+	_, err = MapWithIndexErr([]int{10, 20, 30}, func(i, x int) (int, error) {
+		if x == 30 {
+			return 0, errBoom
+		}
+		return x, nil
+	})
+	var idxErr *IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 2, idxErr.Index)
+	assert.ErrorIs(t, err, errBoom)
+	assert.Contains(t, err.Error(), "下标 2")
+}
@@ -0,0 +1,67 @@
+package slice
+
+// 多重集合（Multiset）版本的集合运算会考虑元素出现的次数。
+// 设元素 x 在 a 中出现 ca 次，在 b 中出现 cb 次，那么 x 在结果中出现的次数为：
+// 并集 max(ca, cb)，交集 min(ca, cb)，差集 max(ca-cb, 0)，对称差集 |ca-cb|。
+// 结果中元素的顺序是确定的，详见各个函数的说明。
+
+// MultisetUnion 多重集合的并集
+// 先按顺序列出 a 中的全部元素，再按顺序列出 b 中超出 a 的那部分元素
+func MultisetUnion[T comparable](a, b []T) []T {
+	ans := make([]T, 0, len(a))
+	counts := countOf(a)
+	ans = append(ans, a...)
+	for _, v := range b {
+		if counts[v] > 0 {
+			counts[v]--
+			continue
+		}
+		ans = append(ans, v)
+	}
+	return ans
+}
+
+// MultisetIntersection 多重集合的交集
+// 按顺序保留 a 中的元素，直到该元素的个数达到它在 b 中出现的次数
+func MultisetIntersection[T comparable](a, b []T) []T {
+	ans := []T{}
+	counts := countOf(b)
+	for _, v := range a {
+		if counts[v] > 0 {
+			counts[v]--
+			ans = append(ans, v)
+		}
+	}
+	return ans
+}
+
+// MultisetDifference 多重集合的差集
+// 按顺序遍历 a，元素在 b 中每出现一次，就抵消 a 中最靠前的一个该元素
+func MultisetDifference[T comparable](a, b []T) []T {
+	ans := []T{}
+	counts := countOf(b)
+	for _, v := range a {
+		if counts[v] > 0 {
+			counts[v]--
+			continue
+		}
+		ans = append(ans, v)
+	}
+	return ans
+}
+
+// MultisetSymmetricDifference 多重集合的对称差集
+// 先列出 MultisetDifference(a, b)，再列出 MultisetDifference(b, a)
+func MultisetSymmetricDifference[T comparable](a, b []T) []T {
+	ans := MultisetDifference(a, b)
+	return append(ans, MultisetDifference(b, a)...)
+}
+
+// countOf 统计每个元素出现的次数
+func countOf[T comparable](src []T) map[T]int {
+	counts := make(map[T]int, len(src))
+	for _, v := range src {
+		counts[v]++
+	}
+	return counts
+}
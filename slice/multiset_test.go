@@ -0,0 +1,54 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultisetOps(t *testing.T) {
+	tests := []struct {
+		name              string
+		a, b              []int
+		wantUnion         []int
+		wantIntersection  []int
+		wantDifference    []int
+		wantSymmetricDiff []int
+	}{
+		{
+			name:              "都为空",
+			a:                 []int{},
+			b:                 []int{},
+			wantUnion:         []int{},
+			wantIntersection:  []int{},
+			wantDifference:    []int{},
+			wantSymmetricDiff: []int{},
+		},
+		{
+			name:              "没有重复元素",
+			a:                 []int{1, 2, 3},
+			b:                 []int{3, 4},
+			wantUnion:         []int{1, 2, 3, 4},
+			wantIntersection:  []int{3},
+			wantDifference:    []int{1, 2},
+			wantSymmetricDiff: []int{1, 2, 4},
+		},
+		{
+			name:              "考虑出现次数",
+			a:                 []int{1, 1, 2, 1, 3},
+			b:                 []int{1, 2, 2, 4, 1},
+			wantUnion:         []int{1, 1, 2, 1, 3, 2, 4},
+			wantIntersection:  []int{1, 1, 2},
+			wantDifference:    []int{1, 3},
+			wantSymmetricDiff: []int{1, 3, 2, 4},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantUnion, MultisetUnion(tc.a, tc.b))
+			assert.Equal(t, tc.wantIntersection, MultisetIntersection(tc.a, tc.b))
+			assert.Equal(t, tc.wantDifference, MultisetDifference(tc.a, tc.b))
+			assert.Equal(t, tc.wantSymmetricDiff, MultisetSymmetricDifference(tc.a, tc.b))
+		})
+	}
+}
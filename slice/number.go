@@ -0,0 +1,182 @@
+package slice
+
+import (
+	"math"
+	"slices"
+
+	"mkit/internal/errs"
+)
+
+// 整数运算在溢出时返回包含溢出位置的 *IndexError，可以通过 errors.Is(err, ErrOverflow) 判断；
+// 浮点数运算遵循 IEEE 754，溢出时得到 ±Inf，NaN 会传播到结果中。
+
+// Sum 求和，空切片返回 0
+// 整数溢出时返回错误，错误中的下标是导致溢出的元素
+func Sum[T Number](src []T) (T, error) {
+	var sum T
+	float := isFloat[T]()
+	for i, v := range src {
+		s := sum + v
+		if !float && ((v > 0 && s < sum) || (v < 0 && s > sum)) {
+			var zeroValue T
+			return zeroValue, &IndexError{Index: i, Err: ErrOverflow}
+		}
+		sum = s
+	}
+	return sum, nil
+}
+
+// Product 求积，空切片返回 1
+// 整数溢出时返回错误，错误中的下标是导致溢出的元素
+func Product[T Number](src []T) (T, error) {
+	var prod T = 1
+	float := isFloat[T]()
+	for i, v := range src {
+		p := prod * v
+		// 对于 -1 * MinInt，p / prod 同样等于 v，需要额外判断符号
+		if !float && prod != 0 && (p/prod != v || (prod < 0 && v < 0 && p <= 0)) {
+			var zeroValue T
+			return zeroValue, &IndexError{Index: i, Err: ErrOverflow}
+		}
+		prod = p
+	}
+	return prod, nil
+}
+
+// Min 返回最小值，空切片返回 ErrEmptySlice
+// 与内置的 min 相同，浮点数中存在 NaN 时返回 NaN
+func Min[T Ordered](src []T) (T, error) {
+	if len(src) == 0 {
+		var zeroValue T
+		return zeroValue, ErrEmptySlice
+	}
+	res := src[0]
+	for _, v := range src[1:] {
+		res = min(res, v)
+	}
+	return res, nil
+}
+
+// Max 返回最大值，空切片返回 ErrEmptySlice
+// 与内置的 max 相同，浮点数中存在 NaN 时返回 NaN
+func Max[T Ordered](src []T) (T, error) {
+	if len(src) == 0 {
+		var zeroValue T
+		return zeroValue, ErrEmptySlice
+	}
+	res := src[0]
+	for _, v := range src[1:] {
+		res = max(res, v)
+	}
+	return res, nil
+}
+
+// MinBy 按照 cmp 返回最小的元素，存在多个时返回第一个，空切片返回 ErrEmptySlice
+func MinBy[T any](src []T, cmp func(a, b T) int) (T, error) {
+	if len(src) == 0 {
+		var zeroValue T
+		return zeroValue, ErrEmptySlice
+	}
+	res := src[0]
+	for _, v := range src[1:] {
+		if cmp(v, res) < 0 {
+			res = v
+		}
+	}
+	return res, nil
+}
+
+// MaxBy 按照 cmp 返回最大的元素，存在多个时返回第一个，空切片返回 ErrEmptySlice
+func MaxBy[T any](src []T, cmp func(a, b T) int) (T, error) {
+	if len(src) == 0 {
+		var zeroValue T
+		return zeroValue, ErrEmptySlice
+	}
+	res := src[0]
+	for _, v := range src[1:] {
+		if cmp(v, res) > 0 {
+			res = v
+		}
+	}
+	return res, nil
+}
+
+// Average 求平均值，空切片返回 ErrEmptySlice
+// 在 float64 中累加，因此整数不会溢出，但是绝对值超过 2^53 的整数会损失精度；存在 NaN 时返回 NaN
+func Average[T Number](src []T) (float64, error) {
+	if len(src) == 0 {
+		return 0, ErrEmptySlice
+	}
+	var sum float64
+	for _, v := range src {
+		sum += float64(v)
+	}
+	return sum / float64(len(src)), nil
+}
+
+// Median 求中位数，长度为偶数时返回中间两个数的平均值，不会修改 src
+// 空切片返回 ErrEmptySlice，存在 NaN 时返回 NaN
+// 结果的精度限制与 Percentile 相同
+func Median[T Number](src []T) (float64, error) {
+	return Percentile(src, 50)
+}
+
+// Percentile 求第 p 百分位数，p 的范围是 [0, 100]，不会修改 src
+// 排名为 p / 100 * (len(src) - 1)，落在两个元素之间时线性插值
+// 排序在 T 上进行，因此选出的元素总是准确的；但结果是 float64，
+// 绝对值超过 2^53 的整数在转换时会丢失精度，插值时误差也可能随之放大
+// 空切片返回 ErrEmptySlice，p 超出范围时返回错误，存在 NaN 时返回 NaN
+func Percentile[T Number](src []T, p float64) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, errs.NewErrInvalidArgument("p", p)
+	}
+	if len(src) == 0 {
+		return 0, ErrEmptySlice
+	}
+	for _, v := range src {
+		if v != v {
+			return math.NaN(), nil
+		}
+	}
+	sorted := slices.Clone(src)
+	slices.Sort(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(rank)
+	// 排名恰好落在某个元素上时直接返回该元素，不经过插值运算
+	if lo == len(sorted)-1 || rank == float64(lo) {
+		return float64(sorted[lo]), nil
+	}
+	a, b := float64(sorted[lo]), float64(sorted[lo+1])
+	return a + (b-a)*(rank-float64(lo)), nil
+}
+
+// Histogram 按照 bounds 统计每个区间中的元素个数，bounds 必须严格递增
+// 返回的切片长度为 len(bounds)+1：第 0 个区间是 (-∞, bounds[0])，
+// 第 i 个区间是 [bounds[i-1], bounds[i])，最后一个区间是 [bounds[len(bounds)-1], +∞)
+// NaN 不属于任何区间，不会被统计
+func Histogram[T Ordered](src []T, bounds []T) ([]int, error) {
+	for i, b := range bounds {
+		if b != b || (i > 0 && !(bounds[i-1] < b)) {
+			return nil, errs.NewErrInvalidArgument("bounds", bounds)
+		}
+	}
+	counts := make([]int, len(bounds)+1)
+	for _, v := range src {
+		if v != v {
+			continue
+		}
+		// 第一个大于 v 的边界的下标，即 v 所在的区间
+		i, found := slices.BinarySearch(bounds, v)
+		if found {
+			i++
+		}
+		counts[i]++
+	}
+	return counts, nil
+}
+
+// isFloat 判断 T 是否为浮点数
+func isFloat[T Number]() bool {
+	var one T = 1
+	return one/2 != 0
+}
@@ -0,0 +1,280 @@
+package slice
+
+import (
+	"cmp"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type celsius float64
+
+type score int8
+
+func TestSum(t *testing.T) {
+	got, err := Sum([]int{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, 6, got)
+
+	got, err = Sum([]int{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	c, err := Sum([]celsius{1.5, 2.5})
+	require.NoError(t, err)
+	assert.Equal(t, celsius(4), c)
+
+	// 中间结果在范围之内，不算溢出
+	s, err := Sum([]score{100, 27, -100, 100})
+	require.NoError(t, err)
+	assert.Equal(t, score(127), s)
+}
+
+func TestSum_Overflow(t *testing.T) {
+	tests := []struct {
+		name      string
+		sum       func() error
+		wantIndex int
+	}{
+		{name: "int8 上溢", sum: func() error { _, err := Sum([]int8{100, 27, 1}); return err }, wantIndex: 2},
+		{name: "int8 下溢", sum: func() error { _, err := Sum([]int8{-100, -29}); return err }, wantIndex: 1},
+		{name: "uint8 上溢", sum: func() error { _, err := Sum([]uint8{200, 56}); return err }, wantIndex: 1},
+		{name: "int64 上溢", sum: func() error { _, err := Sum([]int64{math.MaxInt64, 1}); return err }, wantIndex: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sum()
+			assert.ErrorIs(t, err, ErrOverflow)
+			var idxErr *IndexError
+			require.ErrorAs(t, err, &idxErr)
+			assert.Equal(t, tc.wantIndex, idxErr.Index)
+		})
+	}
+}
+
+func TestSum_Float(t *testing.T) {
+	// 浮点数溢出得到 +Inf，而不是错误
+	got, err := Sum([]float64{math.MaxFloat64, math.MaxFloat64})
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(got, 1))
+
+	got, err = Sum([]float64{1, math.NaN(), 2})
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(got))
+}
+
+func TestProduct(t *testing.T) {
+	got, err := Product([]int{2, 3, 4})
+	require.NoError(t, err)
+	assert.Equal(t, 24, got)
+
+	got, err = Product([]int{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	got, err = Product([]int{5, 0, math.MaxInt, math.MaxInt})
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	s, err := Product([]score{-1, 64, 2})
+	require.NoError(t, err)
+	assert.Equal(t, score(-128), s)
+
+	f, err := Product([]float64{0.1, 3})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.3, f, 1e-12)
+}
+
+func TestProduct_Overflow(t *testing.T) {
+	tests := []struct {
+		name      string
+		product   func() error
+		wantIndex int
+	}{
+		{name: "int8 上溢", product: func() error { _, err := Product([]int8{16, 8}); return err }, wantIndex: 1},
+		{name: "-1 * MinInt8", product: func() error { _, err := Product([]int8{-1, math.MinInt8}); return err }, wantIndex: 1},
+		{name: "MinInt8 * -1", product: func() error { _, err := Product([]int8{math.MinInt8, -1}); return err }, wantIndex: 1},
+		{name: "uint16 上溢", product: func() error { _, err := Product([]uint16{256, 2, 128}); return err }, wantIndex: 2},
+		{name: "int64 上溢", product: func() error { _, err := Product([]int64{math.MaxInt64 / 2, 3}); return err }, wantIndex: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.product()
+			assert.ErrorIs(t, err, ErrOverflow)
+			var idxErr *IndexError
+			require.ErrorAs(t, err, &idxErr)
+			assert.Equal(t, tc.wantIndex, idxErr.Index)
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	lo, err := Min([]int{3, 1, 4, 1, 5})
+	require.NoError(t, err)
+	assert.Equal(t, 1, lo)
+	hi, err := Max([]int{3, 1, 4, 1, 5})
+	require.NoError(t, err)
+	assert.Equal(t, 5, hi)
+
+	s, err := Max([]string{"b", "c", "a"})
+	require.NoError(t, err)
+	assert.Equal(t, "c", s)
+
+	_, err = Min([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = Max([]int(nil))
+	assert.ErrorIs(t, err, ErrEmptySlice)
+
+	// NaN 会传播到结果中，与内置的 min 和 max 相同
+	f, err := Min([]float64{1, math.NaN(), -1})
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(f))
+	f, err = Max([]float64{1, math.NaN(), 2})
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(f))
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"a", 30}, {"b", 20}, {"c", 30}, {"d", 20}}
+	byAge := func(a, b user) int { return cmp.Compare(a.age, b.age) }
+
+	youngest, err := MinBy(users, byAge)
+	require.NoError(t, err)
+	assert.Equal(t, user{"b", 20}, youngest)
+	oldest, err := MaxBy(users, byAge)
+	require.NoError(t, err)
+	assert.Equal(t, user{"a", 30}, oldest)
+
+	_, err = MinBy([]user{}, byAge)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = MaxBy([]user{}, byAge)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+}
+
+func TestAverage(t *testing.T) {
+	avg, err := Average([]int{1, 2, 3, 4})
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, avg)
+
+	// 在 float64 中累加，整数不会溢出
+	avg, err = Average([]int8{127, 127})
+	require.NoError(t, err)
+	assert.Equal(t, 127.0, avg)
+
+	_, err = Average([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+
+	avg, err = Average([]float32{1, float32(math.NaN())})
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(avg))
+}
+
+func TestPercentile(t *testing.T) {
+	src := []int{15, 20, 35, 40, 50}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{p: 0, want: 15},
+		{p: 25, want: 20},
+		{p: 40, want: 29},
+		{p: 50, want: 35},
+		{p: 90, want: 46},
+		{p: 100, want: 50},
+	}
+	for _, tc := range tests {
+		got, err := Percentile(src, tc.p)
+		require.NoError(t, err)
+		assert.InDelta(t, tc.want, got, 1e-9, "p = %v", tc.p)
+	}
+	// 不会修改原切片
+	assert.Equal(t, []int{15, 20, 35, 40, 50}, src)
+
+	for _, p := range []float64{-1, 100.5, math.NaN()} {
+		_, err := Percentile(src, p)
+		assert.Error(t, err, "p = %v", p)
+	}
+	_, err := Percentile([]int{}, 50)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+
+	got, err := Percentile([]float64{1, math.NaN()}, 50)
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(got))
+
+	// 排名恰好落在元素上时直接返回该元素，不受插值运算的影响
+	got, err = Percentile([]float64{math.Inf(1), math.Inf(1)}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, math.Inf(1), got)
+}
+
+func TestPercentile_LargeInt(t *testing.T) {
+	const big = int64(1) << 62
+	src := []int64{big + 3, -big, big + 1, big + 2, big}
+	tests := []struct {
+		p    float64
+		want int64
+	}{
+		{p: 0, want: -big},
+		{p: 25, want: big},
+		{p: 50, want: big + 1},
+		{p: 75, want: big + 2},
+		{p: 100, want: big + 3},
+	}
+	for _, tc := range tests {
+		got, err := Percentile(src, tc.p)
+		require.NoError(t, err)
+		// 结果是 float64，超过 2^53 的整数只能精确到最接近的 float64
+		assert.Equal(t, float64(tc.want), got, "p = %v", tc.p)
+	}
+	got, err := Percentile(src, 12.5)
+	require.NoError(t, err)
+	assert.InDelta(t, 0, got, 1)
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name string
+		src  []int
+		want float64
+	}{
+		{name: "奇数个", src: []int{3, 1, 2}, want: 2},
+		{name: "偶数个", src: []int{4, 1, 3, 2}, want: 2.5},
+		{name: "一个", src: []int{7}, want: 7},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Median(tc.src)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+	_, err := Median([]celsius{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+}
+
+func TestHistogram(t *testing.T) {
+	got, err := Histogram([]int{-5, 0, 1, 9, 10, 10, 25, 100}, []int{0, 10, 20})
+	require.NoError(t, err)
+	// (-∞, 0), [0, 10), [10, 20), [20, +∞)
+	assert.Equal(t, []int{1, 3, 2, 2}, got)
+
+	got, err = Histogram([]int{1, 2}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, got)
+
+	// NaN 不会被统计
+	got, err = Histogram([]float64{0.5, math.NaN(), 1.5}, []float64{1})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 1}, got)
+
+	for _, bounds := range [][]float64{{1, 1}, {2, 1}, {math.NaN()}} {
+		_, err = Histogram([]float64{1}, bounds)
+		assert.Error(t, err, "bounds = %v", bounds)
+	}
+}
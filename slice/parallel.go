@@ -0,0 +1,139 @@
+package slice
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"mkit/internal/errs"
+)
+
+// ParallelMap 与 Map 相同，但是最多使用 limit 个 goroutine 并发地执行 f，结果保持 src 中的顺序
+// limit 小于等于 0 时使用 runtime.GOMAXPROCS(0)
+// 任意一次 f 返回错误或者发生 panic 时，不再开始新的任务，传给 f 的 ctx 会被取消，并返回第一个错误；
+// ctx 被取消时返回 ctx.Err()
+func ParallelMap[T, U any](ctx context.Context, src []T, limit int,
+	f func(ctx context.Context, t T) (U, error)) ([]U, error) {
+	res := make([]U, len(src))
+	err := parallelDo(ctx, len(src), limit, func(ctx context.Context, i int) error {
+		u, err := f(ctx, src[i])
+		res[i] = u
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ParallelFilter 最多使用 limit 个 goroutine 并发地执行 match，返回满足 match 的元素，结果保持 src 中的顺序
+// limit 以及错误的处理与 ParallelMap 相同
+func ParallelFilter[T any](ctx context.Context, src []T, limit int,
+	match func(ctx context.Context, t T) (bool, error)) ([]T, error) {
+	keep := make([]bool, len(src))
+	err := parallelDo(ctx, len(src), limit, func(ctx context.Context, i int) error {
+		ok, err := match(ctx, src[i])
+		keep[i] = ok
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]T, 0, len(src))
+	for i, v := range src {
+		if keep[i] {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+// ParallelReduce 将 src 切分为最多 limit 个连续的片段，每个片段在一个 goroutine 中从 identity 开始用 f 聚合，
+// 再按照片段的顺序用 combine 合并各个片段的结果。
+// 为了保证结果与 Reduce 相同，combine 必须满足结合律，identity 必须是 combine 的单位元，
+// 并且 f(acc, t) 需要等于 combine(acc, f(identity, t))，例如求和时 identity 为 0，combine 为加法
+// limit 以及错误的处理与 ParallelMap 相同
+func ParallelReduce[T, U any](ctx context.Context, src []T, limit int, identity U,
+	f func(ctx context.Context, acc U, t T) (U, error), combine func(a, b U) U) (U, error) {
+	limit = min(resolveLimit(limit), len(src))
+	partial := make([]U, limit)
+	err := parallelDo(ctx, limit, limit, func(ctx context.Context, i int) error {
+		// 第 i 个片段为 [len(src)*i/limit, len(src)*(i+1)/limit)
+		acc := identity
+		for _, v := range src[len(src)*i/limit : len(src)*(i+1)/limit] {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var err error
+			if acc, err = f(ctx, acc, v); err != nil {
+				return err
+			}
+		}
+		partial[i] = acc
+		return nil
+	})
+	if err != nil {
+		var zeroValue U
+		return zeroValue, err
+	}
+	return Reduce(partial, identity, combine), nil
+}
+
+func resolveLimit(limit int) int {
+	if limit <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return limit
+}
+
+// parallelDo 最多使用 limit 个 goroutine 对 [0, n) 中的每个下标执行 fn，返回第一个错误
+func parallelDo(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		next     atomic.Int64
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	setErr := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+	workers := min(resolveLimit(limit), n)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				if err := ctx.Err(); err != nil {
+					setErr(err)
+					return
+				}
+				if err := safeCall(ctx, i, fn); err != nil {
+					setErr(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// safeCall 执行 fn，并将 panic 转化为错误
+func safeCall(ctx context.Context, i int, fn func(ctx context.Context, i int) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.NewErrPanic(r)
+		}
+	}()
+	return fn(ctx, i)
+}
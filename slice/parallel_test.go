@@ -0,0 +1,146 @@
+package slice
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parallelTestSrc(n int) []int {
+	src := make([]int, n)
+	for i := range src {
+		src[i] = i
+	}
+	return src
+}
+
+func TestParallelMap(t *testing.T) {
+	src := parallelTestSrc(1000)
+	for _, limit := range []int{-1, 0, 1, 3, 2000} {
+		t.Run(strconv.Itoa(limit), func(t *testing.T) {
+			got, err := ParallelMap(context.Background(), src, limit, func(ctx context.Context, i int) (string, error) {
+				return strconv.Itoa(i), nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, Map(src, strconv.Itoa), got)
+		})
+	}
+
+	got, err := ParallelMap(context.Background(), []int{}, 4, func(ctx context.Context, i int) (int, error) {
+		return i, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{}, got)
+}
+
+// 同时运行的任务数不超过 limit
+func TestParallelMap_Limit(t *testing.T) {
+	var running, peak atomic.Int64
+	_, err := ParallelMap(context.Background(), parallelTestSrc(100), 3, func(ctx context.Context, i int) (int, error) {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if cur <= old || peak.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return i, nil
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int64(3))
+}
+
+// 出现错误之后不再开始新的任务，并且返回第一个错误
+func TestParallelMap_Error(t *testing.T) {
+	errBoom := errors.New("boom")
+	var calls atomic.Int64
+	got, err := ParallelMap(context.Background(), parallelTestSrc(10000), 4, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i == 10 {
+			return 0, errBoom
+		}
+		return i, nil
+	})
+	assert.ErrorIs(t, err, errBoom)
+	assert.Nil(t, got)
+	assert.Less(t, calls.Load(), int64(10000))
+}
+
+func TestParallelMap_Panic(t *testing.T) {
+	_, err := ParallelMap(context.Background(), parallelTestSrc(100), 4, func(ctx context.Context, i int) (int, error) {
+		if i == 50 {
+			panic("oops")
+		}
+		return i, nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "oops")
+}
+
+func TestParallelMap_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ParallelMap(ctx, parallelTestSrc(100), 4, func(ctx context.Context, i int) (int, error) {
+		return i, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParallelFilter(t *testing.T) {
+	src := parallelTestSrc(1000)
+	even := func(i int) bool { return i%2 == 0 }
+	got, err := ParallelFilter(context.Background(), src, 8, func(ctx context.Context, i int) (bool, error) {
+		return even(i), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, FilterFunc(src, even), got)
+
+	errBoom := errors.New("boom")
+	got, err = ParallelFilter(context.Background(), src, 8, func(ctx context.Context, i int) (bool, error) {
+		if i == 500 {
+			return false, errBoom
+		}
+		return true, nil
+	})
+	assert.ErrorIs(t, err, errBoom)
+	assert.Nil(t, got)
+}
+
+func TestParallelReduce(t *testing.T) {
+	add := func(ctx context.Context, acc, i int) (int, error) { return acc + i, nil }
+	plus := func(a, b int) int { return a + b }
+	for _, n := range []int{0, 1, 7, 1000} {
+		src := parallelTestSrc(n)
+		for _, limit := range []int{0, 1, 3, 2000} {
+			got, err := ParallelReduce(context.Background(), src, limit, 0, add, plus)
+			require.NoError(t, err)
+			assert.Equal(t, n*(n-1)/2, got, "n = %d, limit = %d", n, limit)
+		}
+	}
+
+	// 结合律但不满足交换律的拼接，结果保持顺序
+	strs := Map(parallelTestSrc(100), strconv.Itoa)
+	got, err := ParallelReduce(context.Background(), strs, 7, "",
+		func(ctx context.Context, acc, s string) (string, error) { return acc + s + ",", nil },
+		func(a, b string) string { return a + b })
+	require.NoError(t, err)
+	assert.Equal(t, Reduce(strs, "", func(acc, s string) string { return acc + s + "," }), got)
+
+	errBoom := errors.New("boom")
+	_, err = ParallelReduce(context.Background(), parallelTestSrc(100), 4, 0,
+		func(ctx context.Context, acc, i int) (int, error) {
+			if i == 60 {
+				return 0, errBoom
+			}
+			return acc + i, nil
+		}, plus)
+	assert.ErrorIs(t, err, errBoom)
+}
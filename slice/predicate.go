@@ -0,0 +1,37 @@
+package slice
+
+// Any 判断 src 中是否存在满足 match 的元素，src 为空时返回 false
+func Any[T any](src []T, match func(t T) bool) bool {
+	return FindIndex(src, match) >= 0
+}
+
+// All 判断 src 中的元素是否都满足 match，src 为空时返回 true
+func All[T any](src []T, match func(t T) bool) bool {
+	for _, v := range src {
+		if !match(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// None 判断 src 中的元素是否都不满足 match，src 为空时返回 true
+func None[T any](src []T, match func(t T) bool) bool {
+	return !Any(src, match)
+}
+
+// Count 返回 dst 在 src 中出现的次数
+func Count[T comparable](src []T, dst T) int {
+	return CountFunc(src, func(t T) bool { return t == dst })
+}
+
+// CountFunc 返回 src 中满足 match 的元素个数
+func CountFunc[T any](src []T, match func(t T) bool) int {
+	cnt := 0
+	for _, v := range src {
+		if match(v) {
+			cnt++
+		}
+	}
+	return cnt
+}
@@ -0,0 +1,40 @@
+package slice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredicate(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name      string
+		src       []int
+		wantAny   bool
+		wantAll   bool
+		wantNone  bool
+		wantCount int
+	}{
+		{name: "部分满足", src: []int{1, 2, 3, 4}, wantAny: true, wantAll: false, wantNone: false, wantCount: 2},
+		{name: "全部满足", src: []int{2, 4}, wantAny: true, wantAll: true, wantNone: false, wantCount: 2},
+		{name: "都不满足", src: []int{1, 3}, wantAny: false, wantAll: false, wantNone: true, wantCount: 0},
+		{name: "空切片", src: []int{}, wantAny: false, wantAll: true, wantNone: true, wantCount: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantAny, Any(tc.src, even))
+			assert.Equal(t, tc.wantAll, All(tc.src, even))
+			assert.Equal(t, tc.wantNone, None(tc.src, even))
+			assert.Equal(t, tc.wantCount, CountFunc(tc.src, even))
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	src := []string{"a", "b", "a", "c", "a"}
+	assert.Equal(t, 3, Count(src, "a"))
+	assert.Equal(t, 1, Count(src, "c"))
+	assert.Equal(t, 0, Count(src, "d"))
+	assert.Equal(t, 0, Count(nil, "a"))
+}
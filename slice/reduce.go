@@ -19,3 +19,23 @@ func ReduceWithIndex[T, U any](src []T, initial U, f func(U, int, T) U) U {
 	}
 	return result
 }
+
+// ReduceErr 与 Reduce 相同，但是 f 可以返回错误
+// 遇到第一个错误时立即停止，返回零值和包含该元素下标的 *IndexError
+func ReduceErr[T, U any](src []T, initial U, f func(U, T) (U, error)) (U, error) {
+	return ReduceWithIndexErr(src, initial, func(acc U, _ int, t T) (U, error) { return f(acc, t) })
+}
+
+// ReduceWithIndexErr 与 ReduceWithIndex 相同，但是 f 可以返回错误
+// 遇到第一个错误时立即停止，返回零值和包含该元素下标的 *IndexError
+func ReduceWithIndexErr[T, U any](src []T, initial U, f func(U, int, T) (U, error)) (U, error) {
+	result := initial
+	for i, v := range src {
+		var err error
+		if result, err = f(result, i, v); err != nil {
+			var zeroValue U
+			return zeroValue, &IndexError{Index: i, Err: err}
+		}
+	}
+	return result, nil
+}
@@ -1,7 +1,11 @@
 package slice
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestReduce(t *testing.T) {
@@ -147,3 +151,49 @@ func TestReduce_OriginalUnchanged(t *testing.T) {
 		}
 	}
 }
+
+func TestReduceErr(t *testing.T) {
+	errBoom := errors.New("boom")
+	sum := func(acc, x int) (int, error) {
+		if x < 0 {
+			return 0, errBoom
+		}
+		return acc + x, nil
+	}
+	got, err := ReduceErr([]int{1, 2, 3}, 10, sum)
+	require.NoError(t, err)
+	assert.Equal(t, 16, got)
+
+	got, err = ReduceErr([]int{}, 10, sum)
+	require.NoError(t, err)
+	assert.Equal(t, 10, got)
+
+	got, err = ReduceErr([]int{1, -2, 3}, 10, sum)
+	assert.Equal(t, 0, got)
+	var idxErr *IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 1, idxErr.Index)
+	assert.ErrorIs(t, err, errBoom)
+}
+
+func TestReduceWithIndexErr(t *testing.T) {
+	errBoom := errors.New("boom")
+	got, err := ReduceWithIndexErr([]int{5, 5, 5}, 0, func(acc, i, x int) (int, error) {
+		return acc + i*x, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 15, got)
+
+	var calls int
+	_, err = ReduceWithIndexErr([]int{5, 5, 5}, 0, func(acc, i, x int) (int, error) {
+		calls++
+		if i == 0 {
+			return 0, errBoom
+		}
+		return acc, nil
+	})
+	var idxErr *IndexError
+	require.ErrorAs(t, err, &idxErr)
+	assert.Equal(t, 0, idxErr.Index)
+	assert.Equal(t, 1, calls)
+}
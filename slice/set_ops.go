@@ -27,22 +27,41 @@ func Equal[T comparable](a, b T) bool { return a == b }
 //    - 时间复杂度为 O(n*m)，效率较低，仅适用于数据量很小的场景。
 // 在 Go 语言泛型实现中，通常采用哈希表法，利用 map[T]struct{} 来高效判断元素是否存在。
 
+// 本文件中的集合运算都是确定性的：结果按照元素第一次出现的顺序排列，并且不包含重复元素。
+// 需要考虑元素出现次数的场景，请使用 multiset.go 中的多重集合版本。
+
 // 交集，(A ∩ B)，用于找出两个集合中共同的元素。
+// 结果按照元素在 a 中第一次出现的顺序排列
 func Intersection[T comparable](a, b []T) []T {
 	ans := []T{}
-	mp := make(map[T]struct{})
+	inB := toSet(b)
+	seen := make(map[T]struct{}, len(a))
 	for _, v := range a {
-		mp[v] = struct{}{}
-	}
-
-	for _, v := range b {
-		if _, ok := mp[v]; ok {
+		if _, ok := inB[v]; ok && markSeen(seen, v) {
 			ans = append(ans, v)
 		}
 	}
 	return ans
 }
 
+// toSet 将切片中的元素放入 map 中，用于判断元素是否存在
+func toSet[T comparable](src []T) map[T]struct{} {
+	mp := make(map[T]struct{}, len(src))
+	for _, v := range src {
+		mp[v] = struct{}{}
+	}
+	return mp
+}
+
+// markSeen 记录 v 已经出现过，返回 v 是否是第一次出现
+func markSeen[T comparable](seen map[T]struct{}, v T) bool {
+	if _, ok := seen[v]; ok {
+		return false
+	}
+	seen[v] = struct{}{}
+	return true
+}
+
 // 并集（Union）的常见实现方法：
 // 1. 哈希表法：
 //    - 先将第一个集合（如切片 a）中的所有元素放入一个 map（哈希表）中，标记存在。
@@ -59,19 +78,17 @@ func Intersection[T comparable](a, b []T) []T {
 // 在 Go 语言泛型实现中，通常采用哈希表法，利用 map[T]struct{} 来高效去重，得到并集。
 
 // 并集，(A ∪ B)，用于找出两个集合中所有的元素。
+// 先按照第一次出现的顺序列出 a 中的元素，再列出只在 b 中出现的元素。
+// 注意不能遍历 map 来生成结果，map 的遍历顺序是随机的
 func Union[T comparable](a, b []T) []T {
 	ans := []T{}
-	mp := make(map[T]struct{}) // map+空字结构体作为value，当作set使用
-	for _, v := range a {
-		mp[v] = struct{}{} // 标记a中存在的元素
-	}
-	for _, v := range b {
-		mp[v] = struct{}{} // 标记b中存在的元素
-	}
-
-	// 遍历mp，将所有元素加入结果集
-	for k := range mp {
-		ans = append(ans, k)
+	seen := make(map[T]struct{}, len(a)+len(b)) // map+空字结构体作为value，当作set使用
+	for _, src := range [][]T{a, b} {
+		for _, v := range src {
+			if markSeen(seen, v) {
+				ans = append(ans, v)
+			}
+		}
 	}
 	return ans
 }
@@ -90,14 +107,13 @@ func Union[T comparable](a, b []T) []T {
 // 在 Go 语言泛型实现中，通常采用哈希表法，利用 map[T]struct{} 来高效判断元素是否存在，从而得到差集。
 
 // 差集，(A - B)，用于找出在A中但不在B中的元素。
+// 结果按照元素在 a 中第一次出现的顺序排列
 func Difference[T comparable](a, b []T) []T {
 	ans := []T{}
-	mp := make(map[T]struct{}) // map+空字结构体作为value，当作set使用
-	for _, v := range b {
-		mp[v] = struct{}{} // 标记b中存在的元素
-	}
+	inB := toSet(b) // 标记b中存在的元素
+	seen := make(map[T]struct{}, len(a))
 	for _, v := range a {
-		if _, ok := mp[v]; !ok { // 如果a中元素在b中不存在，则加入结果集
+		if _, ok := inB[v]; !ok && markSeen(seen, v) { // 如果a中元素在b中不存在，则加入结果集
 			ans = append(ans, v)
 		}
 	}
@@ -118,6 +134,7 @@ func Difference[T comparable](a, b []T) []T {
 // 在 Go 语言泛型实现中，通常采用哈希表法，利用 map[T]struct{} 来高效判断元素是否存在，从而得到对称差集。
 
 // 对称差集，(A - B) ∪ (B - A)，用于找出两个集合之间不重叠的部分。
+// 先列出只在 a 中的元素，再列出只在 b 中的元素
 func SymmetricDifference[T comparable](a, b []T) []T {
 	// 遍历a，将a中有，但b中没有的元素加入到结果集中
 	// 遍历b，将b中有，但a中没有的元素加入到结果集中
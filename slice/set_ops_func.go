@@ -0,0 +1,103 @@
+package slice
+
+// 本文件提供的集合运算适用于不能使用 == 比较的元素类型，语义与 set_ops.go 中的版本相同：
+// 结果按照元素第一次出现的顺序排列，并且不包含重复元素，重复时保留第一次出现的那个元素。
+// ...By 版本通过 key 提取可比较的键，键相同的元素视为同一个元素，时间复杂度为 O(n+m)；
+// ...Func 版本通过 equal 判断元素是否相等，只能两两比较，时间复杂度为 O(n*m)。
+
+// IntersectionBy 交集，结果按照元素在 a 中第一次出现的顺序排列
+func IntersectionBy[T any, K comparable](a, b []T, key func(t T) K) []T {
+	ans := []T{}
+	inB := keySet(b, key)
+	seen := make(map[K]struct{}, len(a))
+	for _, v := range a {
+		k := key(v)
+		if _, ok := inB[k]; ok && markSeen(seen, k) {
+			ans = append(ans, v)
+		}
+	}
+	return ans
+}
+
+// UnionBy 并集，先列出 a 中的元素，再列出只在 b 中出现的元素
+func UnionBy[T any, K comparable](a, b []T, key func(t T) K) []T {
+	ans := []T{}
+	seen := make(map[K]struct{}, len(a)+len(b))
+	for _, src := range [][]T{a, b} {
+		for _, v := range src {
+			if markSeen(seen, key(v)) {
+				ans = append(ans, v)
+			}
+		}
+	}
+	return ans
+}
+
+// DifferenceBy 差集，结果按照元素在 a 中第一次出现的顺序排列
+func DifferenceBy[T any, K comparable](a, b []T, key func(t T) K) []T {
+	ans := []T{}
+	inB := keySet(b, key)
+	seen := make(map[K]struct{}, len(a))
+	for _, v := range a {
+		k := key(v)
+		if _, ok := inB[k]; !ok && markSeen(seen, k) {
+			ans = append(ans, v)
+		}
+	}
+	return ans
+}
+
+// SymmetricDifferenceBy 对称差集，先列出只在 a 中的元素，再列出只在 b 中的元素
+func SymmetricDifferenceBy[T any, K comparable](a, b []T, key func(t T) K) []T {
+	ans := DifferenceBy(a, b, key)
+	return append(ans, DifferenceBy(b, a, key)...)
+}
+
+func keySet[T any, K comparable](src []T, key func(t T) K) map[K]struct{} {
+	mp := make(map[K]struct{}, len(src))
+	for _, v := range src {
+		mp[key(v)] = struct{}{}
+	}
+	return mp
+}
+
+// IntersectionFunc 交集，结果按照元素在 a 中第一次出现的顺序排列
+func IntersectionFunc[T any](a, b []T, equal func(src, dst T) bool) []T {
+	ans := []T{}
+	for _, v := range a {
+		if ContainsFunc(b, v, equal) && !ContainsFunc(ans, v, equal) {
+			ans = append(ans, v)
+		}
+	}
+	return ans
+}
+
+// UnionFunc 并集，先列出 a 中的元素，再列出只在 b 中出现的元素
+func UnionFunc[T any](a, b []T, equal func(src, dst T) bool) []T {
+	ans := []T{}
+	for _, src := range [][]T{a, b} {
+		for _, v := range src {
+			if !ContainsFunc(ans, v, equal) {
+				ans = append(ans, v)
+			}
+		}
+	}
+	return ans
+}
+
+// DifferenceFunc 差集，结果按照元素在 a 中第一次出现的顺序排列
+func DifferenceFunc[T any](a, b []T, equal func(src, dst T) bool) []T {
+	ans := []T{}
+	for _, v := range a {
+		if !ContainsFunc(b, v, equal) && !ContainsFunc(ans, v, equal) {
+			ans = append(ans, v)
+		}
+	}
+	return ans
+}
+
+// SymmetricDifferenceFunc 对称差集，先列出只在 a 中的元素，再列出只在 b 中的元素
+func SymmetricDifferenceFunc[T any](a, b []T, equal func(src, dst T) bool) []T {
+	ans := DifferenceFunc(a, b, equal)
+	return append(ans, DifferenceFunc(b, a, equal)...)
+}
@@ -0,0 +1,81 @@
+package slice
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	id   int
+	tags []string
+}
+
+func TestSetOpsBy(t *testing.T) {
+	a := []user{{id: 1, tags: []string{"a"}}, {id: 2}, {id: 1, tags: []string{"dup"}}, {id: 3}}
+	b := []user{{id: 4}, {id: 2, tags: []string{"b"}}, {id: 5}, {id: 4}}
+	key := func(u user) int { return u.id }
+	ids := func(us []user) []int {
+		return Map(us, key)
+	}
+
+	assert.Equal(t, []int{2}, ids(IntersectionBy(a, b, key)))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, ids(UnionBy(a, b, key)))
+	assert.Equal(t, []int{1, 3}, ids(DifferenceBy(a, b, key)))
+	assert.Equal(t, []int{1, 3, 4, 5}, ids(SymmetricDifferenceBy(a, b, key)))
+
+	// 键重复时保留第一次出现的元素
+	assert.Equal(t, []string{"a"}, UnionBy(a, b, key)[0].tags)
+	assert.Nil(t, IntersectionBy(a, b, key)[0].tags)
+
+	assert.Equal(t, []user{}, UnionBy(nil, nil, key))
+}
+
+func TestSetOpsFunc(t *testing.T) {
+	tests := []struct {
+		name              string
+		a, b              []string
+		wantIntersection  []string
+		wantUnion         []string
+		wantDifference    []string
+		wantSymmetricDiff []string
+	}{
+		{
+			name:              "忽略大小写",
+			a:                 []string{"Go", "java", "GO", "Python"},
+			b:                 []string{"JAVA", "rust", "go"},
+			wantIntersection:  []string{"Go", "java"},
+			wantUnion:         []string{"Go", "java", "Python", "rust"},
+			wantDifference:    []string{"Python"},
+			wantSymmetricDiff: []string{"Python", "rust"},
+		},
+		{
+			name:              "b为空",
+			a:                 []string{"a", "A"},
+			b:                 []string{},
+			wantIntersection:  []string{},
+			wantUnion:         []string{"a"},
+			wantDifference:    []string{"a"},
+			wantSymmetricDiff: []string{"a"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantIntersection, IntersectionFunc(tc.a, tc.b, strings.EqualFold))
+			assert.Equal(t, tc.wantUnion, UnionFunc(tc.a, tc.b, strings.EqualFold))
+			assert.Equal(t, tc.wantDifference, DifferenceFunc(tc.a, tc.b, strings.EqualFold))
+			assert.Equal(t, tc.wantSymmetricDiff, SymmetricDifferenceFunc(tc.a, tc.b, strings.EqualFold))
+
+			// 使用 strings.ToLower 作为键的结果与 equal 版本相同
+			assert.Equal(t, tc.wantUnion, UnionBy(tc.a, tc.b, strings.ToLower))
+			assert.Equal(t, tc.wantSymmetricDiff, SymmetricDifferenceBy(tc.a, tc.b, strings.ToLower))
+		})
+	}
+
+	// 元素本身不可比较
+	a := [][]int{{1}, {2, 3}}
+	b := [][]int{{2, 3}, {4}}
+	assert.Equal(t, [][]int{{2, 3}}, IntersectionFunc(a, b, slices.Equal[[]int]))
+}
@@ -0,0 +1,99 @@
+package slice
+
+// 本文件实现了 set_ops.go 注释中提到的排序+双指针法，适用于已经按照 cmp 从小到大排好序的输入。
+// 只需要同时遍历一次 a 和 b，时间复杂度为 O(n+m)，并且不需要分配 map。
+// 结果同样是按照 cmp 从小到大排列并且去重的；如果输入没有排好序，结果是不确定的。
+
+// SortedIntersection 有序切片的交集
+func SortedIntersection[T any](a, b []T, cmp func(a, b T) int) []T {
+	ans := []T{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			i = skipEqual(a, i, cmp)
+		case c > 0:
+			j = skipEqual(b, j, cmp)
+		default:
+			ans = append(ans, a[i])
+			i, j = skipEqual(a, i, cmp), skipEqual(b, j, cmp)
+		}
+	}
+	return ans
+}
+
+// SortedUnion 有序切片的并集，相等的元素保留 a 中的那个
+func SortedUnion[T any](a, b []T, cmp func(a, b T) int) []T {
+	ans := make([]T, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			ans = append(ans, a[i])
+			i = skipEqual(a, i, cmp)
+		case c > 0:
+			ans = append(ans, b[j])
+			j = skipEqual(b, j, cmp)
+		default:
+			ans = append(ans, a[i])
+			i, j = skipEqual(a, i, cmp), skipEqual(b, j, cmp)
+		}
+	}
+	ans = appendDistinct(ans, a[i:], cmp)
+	return appendDistinct(ans, b[j:], cmp)
+}
+
+// SortedDifference 有序切片的差集，即在 a 中但不在 b 中的元素
+func SortedDifference[T any](a, b []T, cmp func(a, b T) int) []T {
+	ans := []T{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			ans = append(ans, a[i])
+			i = skipEqual(a, i, cmp)
+		case c > 0:
+			j = skipEqual(b, j, cmp)
+		default:
+			i, j = skipEqual(a, i, cmp), skipEqual(b, j, cmp)
+		}
+	}
+	return appendDistinct(ans, a[i:], cmp)
+}
+
+// SortedSymmetricDifference 有序切片的对称差集，结果同样是有序的
+func SortedSymmetricDifference[T any](a, b []T, cmp func(a, b T) int) []T {
+	ans := []T{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp(a[i], b[j]); {
+		case c < 0:
+			ans = append(ans, a[i])
+			i = skipEqual(a, i, cmp)
+		case c > 0:
+			ans = append(ans, b[j])
+			j = skipEqual(b, j, cmp)
+		default:
+			i, j = skipEqual(a, i, cmp), skipEqual(b, j, cmp)
+		}
+	}
+	ans = appendDistinct(ans, a[i:], cmp)
+	return appendDistinct(ans, b[j:], cmp)
+}
+
+// skipEqual 返回 src 中 i 之后第一个与 src[i] 不相等的元素的下标
+func skipEqual[T any](src []T, i int, cmp func(a, b T) int) int {
+	j := i + 1
+	for j < len(src) && cmp(src[i], src[j]) == 0 {
+		j++
+	}
+	return j
+}
+
+// appendDistinct 将有序的 src 去重之后追加到 dst 中
+func appendDistinct[T any](dst, src []T, cmp func(a, b T) int) []T {
+	for i := 0; i < len(src); i = skipEqual(src, i, cmp) {
+		dst = append(dst, src[i])
+	}
+	return dst
+}
@@ -0,0 +1,113 @@
+package slice
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortedSetOps(t *testing.T) {
+	tests := []struct {
+		name              string
+		a, b              []int
+		wantIntersection  []int
+		wantUnion         []int
+		wantDifference    []int
+		wantSymmetricDiff []int
+	}{
+		{
+			name:              "都为空",
+			a:                 []int{},
+			b:                 nil,
+			wantIntersection:  []int{},
+			wantUnion:         []int{},
+			wantDifference:    []int{},
+			wantSymmetricDiff: []int{},
+		},
+		{
+			name:              "无交集",
+			a:                 []int{1, 3},
+			b:                 []int{2, 4, 6},
+			wantIntersection:  []int{},
+			wantUnion:         []int{1, 2, 3, 4, 6},
+			wantDifference:    []int{1, 3},
+			wantSymmetricDiff: []int{1, 2, 3, 4, 6},
+		},
+		{
+			name:              "重复元素",
+			a:                 []int{1, 1, 2, 4, 4, 7},
+			b:                 []int{1, 3, 4, 4, 8, 8},
+			wantIntersection:  []int{1, 4},
+			wantUnion:         []int{1, 2, 3, 4, 7, 8},
+			wantDifference:    []int{2, 7},
+			wantSymmetricDiff: []int{2, 3, 7, 8},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantIntersection, SortedIntersection(tc.a, tc.b, cmp.Compare[int]))
+			assert.Equal(t, tc.wantUnion, SortedUnion(tc.a, tc.b, cmp.Compare[int]))
+			assert.Equal(t, tc.wantDifference, SortedDifference(tc.a, tc.b, cmp.Compare[int]))
+			assert.Equal(t, tc.wantSymmetricDiff, SortedSymmetricDifference(tc.a, tc.b, cmp.Compare[int]))
+		})
+	}
+}
+
+// 有序版本的结果与哈希版本排序之后相同
+func TestSortedSetOps_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randSorted := func() []int {
+		res := make([]int, r.Intn(20))
+		for i := range res {
+			res[i] = r.Intn(15)
+		}
+		slices.Sort(res)
+		return res
+	}
+	sorted := func(src []int) []int {
+		slices.Sort(src)
+		return src
+	}
+	for i := 0; i < 200; i++ {
+		a, b := randSorted(), randSorted()
+		assert.Equal(t, sorted(Intersection(a, b)), SortedIntersection(a, b, cmp.Compare[int]))
+		assert.Equal(t, sorted(Union(a, b)), SortedUnion(a, b, cmp.Compare[int]))
+		assert.Equal(t, sorted(Difference(a, b)), SortedDifference(a, b, cmp.Compare[int]))
+		assert.Equal(t, sorted(SymmetricDifference(a, b)), SortedSymmetricDifference(a, b, cmp.Compare[int]))
+	}
+}
+
+func benchmarkSortedIDs(n, step, offset int) []int {
+	res := make([]int, n)
+	for i := range res {
+		res[i] = i*step + offset
+	}
+	return res
+}
+
+// BenchmarkSetOps 对比有序切片上的双指针法与哈希表法
+func BenchmarkSetOps(b *testing.B) {
+	a, c := benchmarkSortedIDs(100_000, 2, 0), benchmarkSortedIDs(100_000, 3, 0)
+	benchmarks := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "Intersection/Hash", fn: func() { Intersection(a, c) }},
+		{name: "Intersection/Sorted", fn: func() { SortedIntersection(a, c, cmp.Compare[int]) }},
+		{name: "Union/Hash", fn: func() { Union(a, c) }},
+		{name: "Union/Sorted", fn: func() { SortedUnion(a, c, cmp.Compare[int]) }},
+		{name: "Difference/Hash", fn: func() { Difference(a, c) }},
+		{name: "Difference/Sorted", fn: func() { SortedDifference(a, c, cmp.Compare[int]) }},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bm.fn()
+			}
+		})
+	}
+}
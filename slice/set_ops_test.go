@@ -136,3 +136,45 @@ func TestSetOps_String(t *testing.T) {
 		})
 	}
 }
+
+// 集合运算的结果是确定性的，按照第一次出现的顺序排列并且去重
+func TestSetOps_Order(t *testing.T) {
+	tests := []struct {
+		name              string
+		a, b              []string
+		wantIntersection  []string
+		wantUnion         []string
+		wantDifference    []string
+		wantSymmetricDiff []string
+	}{
+		{
+			name:              "保持第一次出现的顺序",
+			a:                 []string{"go", "java", "python"},
+			b:                 []string{"rust", "java", "c++", "go"},
+			wantIntersection:  []string{"go", "java"},
+			wantUnion:         []string{"go", "java", "python", "rust", "c++"},
+			wantDifference:    []string{"python"},
+			wantSymmetricDiff: []string{"python", "rust", "c++"},
+		},
+		{
+			name:              "重复元素",
+			a:                 []string{"b", "a", "b", "c", "a"},
+			b:                 []string{"a", "a", "d", "d"},
+			wantIntersection:  []string{"a"},
+			wantUnion:         []string{"b", "a", "c", "d"},
+			wantDifference:    []string{"b", "c"},
+			wantSymmetricDiff: []string{"b", "c", "d"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// 多次运行结果相同
+			for i := 0; i < 10; i++ {
+				assert.Equal(t, tc.wantIntersection, Intersection(tc.a, tc.b))
+				assert.Equal(t, tc.wantUnion, Union(tc.a, tc.b))
+				assert.Equal(t, tc.wantDifference, Difference(tc.a, tc.b))
+				assert.Equal(t, tc.wantSymmetricDiff, SymmetricDifference(tc.a, tc.b))
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Package stream 提供基于 iter.Seq 的惰性流水线
+// 中间操作（Filter、Map、Take 等）只是组合新的迭代器，不会分配中间切片；
+// 只有在调用 Collect、Reduce 等终止操作时，元素才会逐个流过整条流水线。
+// 由于 Go 的方法不能声明新的类型参数，改变元素类型或者需要 comparable 约束的操作以函数的形式提供
+package stream
+
+import (
+	"errors"
+	"iter"
+	"slices"
+
+	"mkit/list"
+)
+
+// Stream 是一个惰性的元素序列
+// 如果底层的 iter.Seq 可以重复遍历（例如来自切片），Stream 也可以多次执行终止操作
+// 零值 Stream 可以直接使用，表示一个空的序列
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Of 使用 ts 创建 Stream
+func Of[T any](ts ...T) Stream[T] {
+	return FromSlice(ts)
+}
+
+// FromSlice 使用切片创建 Stream，不会复制 ts
+func FromSlice[T any](ts []T) Stream[T] {
+	return Stream[T]{seq: slices.Values(ts)}
+}
+
+// FromSeq 使用 iter.Seq 创建 Stream，seq 为 nil 时表示空的序列
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// errStop 用于在 Range 的回调中提前结束遍历，不会返回给调用者
+var errStop = errors.New("mkit: 停止遍历")
+
+// FromList 使用 list.List 创建 Stream，遍历时调用 l.Range
+func FromList[T any](l list.List[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		_ = l.Range(func(index int, t T) error {
+			if !yield(t) {
+				return errStop
+			}
+			return nil
+		})
+	}}
+}
+
+// Seq 返回底层的 iter.Seq，可以直接用于 for range，零值 Stream 返回一个空的 iter.Seq
+func (s Stream[T]) Seq() iter.Seq[T] {
+	if s.seq == nil {
+		return func(yield func(T) bool) {}
+	}
+	return s.seq
+}
+
+// Filter 只保留满足 match 的元素
+func (s Stream[T]) Filter(match func(t T) bool) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for t := range s.Seq() {
+			if match(t) && !yield(t) {
+				return
+			}
+		}
+	}}
+}
+
+// Peek 在元素流过时调用 fn，常用于调试
+func (s Stream[T]) Peek(fn func(t T)) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		for t := range s.Seq() {
+			fn(t)
+			if !yield(t) {
+				return
+			}
+		}
+	}}
+}
+
+// Take 只保留前 n 个元素，取够之后不会再从上游读取
+func (s Stream[T]) Take(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for t := range s.Seq() {
+			if !yield(t) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}}
+}
+
+// Skip 跳过前 n 个元素
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		i := 0
+		for t := range s.Seq() {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(t) {
+				return
+			}
+		}
+	}}
+}
+
+// Sorted 按照 cmp 从小到大排序，相等的元素保持原有顺序
+// 这是一个有状态的操作，需要先读取上游的所有元素
+func (s Stream[T]) Sorted(cmp func(a, b T) int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		ts := slices.Collect(s.Seq())
+		slices.SortStableFunc(ts, cmp)
+		for _, t := range ts {
+			if !yield(t) {
+				return
+			}
+		}
+	}}
+}
+
+// Map 对每个元素应用 f
+func Map[T, U any](s Stream[T], f func(t T) U) Stream[U] {
+	return Stream[U]{seq: func(yield func(U) bool) {
+		for t := range s.Seq() {
+			if !yield(f(t)) {
+				return
+			}
+		}
+	}}
+}
+
+// FlatMap 对每个元素应用 f，并依次输出 f 返回的所有元素
+func FlatMap[T, U any](s Stream[T], f func(t T) []U) Stream[U] {
+	return Stream[U]{seq: func(yield func(U) bool) {
+		for t := range s.Seq() {
+			for _, u := range f(t) {
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}}
+}
+
+// Distinct 去掉重复的元素，保留第一次出现的元素
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	return DistinctBy(s, func(t T) T { return t })
+}
+
+// DistinctBy 去掉 key 重复的元素，保留第一次出现的元素
+func DistinctBy[T any, K comparable](s Stream[T], key func(t T) K) Stream[T] {
+	return Stream[T]{seq: func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for t := range s.Seq() {
+			k := key(t)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(t) {
+				return
+			}
+		}
+	}}
+}
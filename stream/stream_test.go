@@ -0,0 +1,175 @@
+package stream
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mkit/list"
+	"mkit/slice"
+)
+
+func TestStream_Filter(t *testing.T) {
+	got := Of(1, 2, 3, 4, 5, 6).Filter(func(i int) bool { return i%2 == 0 }).Collect()
+	assert.Equal(t, []int{2, 4, 6}, got)
+	assert.Equal(t, []int{}, Of[int]().Filter(func(i int) bool { return true }).Collect())
+}
+
+func TestStream_TakeSkip(t *testing.T) {
+	tests := []struct {
+		name string
+		s    Stream[int]
+		want []int
+	}{
+		{name: "Take", s: Of(1, 2, 3, 4).Take(2), want: []int{1, 2}},
+		{name: "Take 超过长度", s: Of(1, 2).Take(5), want: []int{1, 2}},
+		{name: "Take 0", s: Of(1, 2).Take(0), want: []int{}},
+		{name: "Skip", s: Of(1, 2, 3, 4).Skip(3), want: []int{4}},
+		{name: "Skip 超过长度", s: Of(1, 2).Skip(5), want: []int{}},
+		{name: "Skip 之后 Take", s: Of(1, 2, 3, 4, 5).Skip(1).Take(3), want: []int{2, 3, 4}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.s.Collect())
+		})
+	}
+}
+
+// 惰性求值：Take 取够之后不会再读取上游的元素
+func TestStream_Lazy(t *testing.T) {
+	var seen []int
+	got := Of(1, 2, 3, 4, 5, 6).
+		Peek(func(i int) { seen = append(seen, i) }).
+		Filter(func(i int) bool { return i%2 == 0 }).
+		Take(2).
+		Collect()
+	assert.Equal(t, []int{2, 4}, got)
+	assert.Equal(t, []int{1, 2, 3, 4}, seen)
+
+	// 没有终止操作时不会执行任何回调
+	var called bool
+	_ = Of(1, 2).Peek(func(i int) { called = true })
+	assert.False(t, called)
+}
+
+func TestStream_Sorted(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	users := []user{{"a", 30}, {"b", 20}, {"c", 30}, {"d", 10}}
+	got := FromSlice(users).Sorted(func(a, b user) int { return cmp.Compare(a.age, b.age) }).Collect()
+	// 相等的元素保持原有顺序
+	assert.Equal(t, []user{{"d", 10}, {"b", 20}, {"a", 30}, {"c", 30}}, got)
+	// 排序不会修改原切片
+	assert.Equal(t, user{"a", 30}, users[0])
+}
+
+func TestMap(t *testing.T) {
+	got := Map(Of(1, 2, 3), strconv.Itoa).Collect()
+	assert.Equal(t, []string{"1", "2", "3"}, got)
+}
+
+func TestFlatMap(t *testing.T) {
+	got := FlatMap(Of("ab", "", "c"), func(s string) []byte { return []byte(s) }).Collect()
+	assert.Equal(t, []byte("abc"), got)
+	// 下游停止时不再继续展开
+	got = FlatMap(Of("ab", "cd"), func(s string) []byte { return []byte(s) }).Take(3).Collect()
+	assert.Equal(t, []byte("abc"), got)
+}
+
+func TestDistinct(t *testing.T) {
+	assert.Equal(t, []int{3, 1, 2}, Distinct(Of(3, 1, 3, 2, 1)).Collect())
+	got := DistinctBy(Of("a", "bb", "c", "dd", "eee"), func(s string) int { return len(s) }).Collect()
+	assert.Equal(t, []string{"a", "bb", "eee"}, got)
+}
+
+func TestFromSeq(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	got := FromSeq(func(yield func(string) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}).Sorted(cmp.Compare[string]).Collect()
+	assert.Equal(t, []string{"a", "b", "c"}, got)
+	assert.Equal(t, []int{1, 2}, FromSeq(slices.Values([]int{1, 2})).Collect())
+}
+
+func TestFromList(t *testing.T) {
+	l := list.NewLinkedListOf([]int{1, 2, 3, 4})
+	assert.Equal(t, []int{1, 2, 3, 4}, FromList[int](l).Collect())
+	// 提前结束遍历
+	assert.Equal(t, []int{1, 2}, FromList[int](l).Take(2).Collect())
+}
+
+// 可以重复执行终止操作
+func TestStream_Reuse(t *testing.T) {
+	s := Of(1, 2, 3).Filter(func(i int) bool { return i > 1 })
+	assert.Equal(t, []int{2, 3}, s.Collect())
+	assert.Equal(t, 2, s.Count())
+}
+
+type benchRecord struct {
+	id    int
+	score int
+}
+
+func benchRecords() []benchRecord {
+	res := make([]benchRecord, 10000)
+	for i := range res {
+		res[i] = benchRecord{id: i, score: i % 100}
+	}
+	return res
+}
+
+// BenchmarkPipeline 对比 slice 包中的函数和 Stream 在 Filter -> Map -> Reduce 上的内存分配
+func BenchmarkPipeline(b *testing.B) {
+	records := benchRecords()
+	match := func(r benchRecord) bool { return r.score >= 50 }
+	score := func(r benchRecord) int { return r.score * 2 }
+	sum := func(acc, s int) int { return acc + s }
+
+	b.Run("Slice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			filtered := slice.FilterFunc(records, match)
+			_ = slice.Reduce(slice.Map(filtered, score), 0, sum)
+		}
+	})
+	b.Run("Stream", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Reduce(Map(FromSlice(records).Filter(match), score), 0, sum)
+		}
+	})
+}
+
+// 零值 Stream 以及 nil 的 iter.Seq 都表示空的序列
+func TestStream_Zero(t *testing.T) {
+	for name, s := range map[string]Stream[int]{
+		"零值":      {},
+		"nil Seq": FromSeq[int](nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, []int{}, s.Collect())
+			assert.Equal(t, 0, s.Count())
+			_, ok := s.First()
+			assert.False(t, ok)
+			assert.False(t, s.AnyMatch(func(int) bool { return true }))
+			s.ForEach(func(int) { t.Fatal("不应该有元素") })
+			assert.Equal(t, 0, s.ToArrayList().Len())
+			assert.Equal(t, 0, ToMapSet(s).Len())
+			assert.Equal(t, 7, Reduce(s, 7, func(acc, i int) int { return acc + i }))
+			assert.Equal(t, []string{}, Map(s.Filter(func(int) bool { return true }).Skip(1).Take(2).Sorted(cmp.Compare[int]), strconv.Itoa).Collect())
+			assert.Equal(t, []int{}, Distinct(FlatMap(s, func(i int) []int { return []int{i} })).Collect())
+			for range s.Seq() {
+				t.Fatal("不应该有元素")
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package stream
+
+import (
+	"slices"
+
+	"mkit/list"
+	"mkit/set"
+)
+
+// Collect 将所有元素收集到一个新切片中
+// 在没有元素的情况下，返回一个长度为 0 的切片
+func (s Stream[T]) Collect() []T {
+	return slices.AppendSeq([]T{}, s.Seq())
+}
+
+// ForEach 对每个元素调用 fn
+func (s Stream[T]) ForEach(fn func(t T)) {
+	for t := range s.Seq() {
+		fn(t)
+	}
+}
+
+// Count 返回元素个数
+func (s Stream[T]) Count() int {
+	cnt := 0
+	for range s.Seq() {
+		cnt++
+	}
+	return cnt
+}
+
+// First 返回第一个元素，没有元素时返回 false
+func (s Stream[T]) First() (T, bool) {
+	for t := range s.Seq() {
+		return t, true
+	}
+	var zeroValue T
+	return zeroValue, false
+}
+
+// AnyMatch 判断是否存在满足 match 的元素，找到之后立即停止
+func (s Stream[T]) AnyMatch(match func(t T) bool) bool {
+	for t := range s.Seq() {
+		if match(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToArrayList 将所有元素收集到一个新的 list.ArrayList 中
+func (s Stream[T]) ToArrayList(opts ...list.ArrayListOption) *list.ArrayList[T] {
+	l := list.NewArrayList[T](opts...)
+	for t := range s.Seq() {
+		_ = l.Append(t)
+	}
+	return l
+}
+
+// ToSet 将所有元素加入 dst 并返回 dst，dst 可以是任意 set.Set 的实现
+func (s Stream[T]) ToSet(dst set.Set[T]) set.Set[T] {
+	for t := range s.Seq() {
+		dst.Add(t)
+	}
+	return dst
+}
+
+// ToMapSet 将所有元素收集到一个新的 set.MapSet 中
+func ToMapSet[T comparable](s Stream[T]) *set.MapSet[T] {
+	dst := set.NewMapSet[T](0)
+	s.ToSet(dst)
+	return dst
+}
+
+// Reduce 从 initial 开始，依次用 f 聚合所有元素
+func Reduce[T, U any](s Stream[T], initial U, f func(acc U, t T) U) U {
+	acc := initial
+	for t := range s.Seq() {
+		acc = f(acc, t)
+	}
+	return acc
+}
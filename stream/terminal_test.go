@@ -0,0 +1,48 @@
+package stream
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"mkit/set"
+)
+
+func TestStream_Terminal(t *testing.T) {
+	s := Of(3, 1, 4, 1, 5)
+	assert.Equal(t, 5, s.Count())
+	first, ok := s.First()
+	assert.True(t, ok)
+	assert.Equal(t, 3, first)
+	_, ok = Of[int]().First()
+	assert.False(t, ok)
+
+	assert.True(t, s.AnyMatch(func(i int) bool { return i > 4 }))
+	assert.False(t, s.AnyMatch(func(i int) bool { return i > 5 }))
+
+	var got []int
+	s.ForEach(func(i int) { got = append(got, i) })
+	assert.Equal(t, []int{3, 1, 4, 1, 5}, got)
+}
+
+func TestReduce(t *testing.T) {
+	assert.Equal(t, 14, Reduce(Of(3, 1, 4, 1, 5), 0, func(acc, i int) int { return acc + i }))
+	assert.Equal(t, "x", Reduce(Of[int](), "x", func(acc string, i int) string { return acc + "y" }))
+}
+
+func TestStream_ToArrayList(t *testing.T) {
+	l := Of(1, 2, 3).Filter(func(i int) bool { return i != 2 }).ToArrayList()
+	assert.Equal(t, []int{1, 3}, l.AsSlice())
+	assert.Equal(t, 0, Of[int]().ToArrayList().Len())
+}
+
+func TestStream_ToSet(t *testing.T) {
+	ts := Of(3, 1, 3, 2).ToSet(set.NewTreeSet[int](cmp.Compare[int]))
+	assert.Equal(t, []int{1, 2, 3}, ts.Keys())
+
+	ms := ToMapSet(Of("a", "b", "a"))
+	assert.Equal(t, 2, ms.Len())
+	assert.True(t, ms.Contains("a"))
+	assert.True(t, ms.Contains("b"))
+}